@@ -0,0 +1,100 @@
+package decimal
+
+import (
+	"fmt"
+	"math/bits"
+	"time"
+)
+
+// Accrual computes amount owed at given rate since last accrual, e.g.
+// interest or fee accruing per second. Amount is computed exactly: parts of
+// 0.00000001 which can't be credited yet are kept in Remainder and added to
+// next accrual, so accruing in many small steps owes the same total as in
+// one step.
+//
+// Elapsed time is difference between absolute instants, so time zones and
+// DST changes don't affect result and leap seconds are not counted. All
+// fields should be persisted together to resume accrual after restart.
+//
+// Example:
+//	accrual := decimal.Accrual{Rate: rate, Per: 24 * time.Hour, Last: opened}
+//	owed, err := accrual.Accrue(time.Now())
+type Accrual struct {
+	// Rate is amount accrued per Per.
+	Rate Decimal
+	Per  time.Duration
+
+	// Last is time of previous accrual.
+	Last time.Time
+
+	// Remainder is part of 0.00000001 accrued but not yet owed, in units of
+	// 0.00000001/Per per nanosecond. It's always less than Per.
+	Remainder uint64
+}
+
+// Accrue returns amount owed since last accrual up to given time and moves
+// Last to now. Nothing is owed if now is not after Last, e.g. because of
+// clock skew between hosts; Last is never moved backwards. Method will
+// return error and leave accrual unchanged if period is not positive or
+// amount can't be stored in Decimal type.
+func (accrual *Accrual) Accrue(now time.Time) (Decimal, error) {
+	owed, remainder, err := accrual.owed(now)
+	if err != nil || !now.After(accrual.Last) {
+		return owed, err
+	}
+
+	accrual.Last = now
+	accrual.Remainder = remainder
+
+	return owed, nil
+}
+
+// Owed returns amount which Accrue() would return at given time without
+// changing accrual.
+func (accrual Accrual) Owed(now time.Time) (Decimal, error) {
+	owed, _, err := accrual.owed(now)
+	return owed, err
+}
+
+func (accrual Accrual) owed(now time.Time) (Decimal, uint64, error) {
+	if accrual.Per <= 0 {
+		return 0, 0, fmt.Errorf(
+			"accrual period should be positive, got %s", accrual.Per,
+		)
+	}
+
+	elapsed := now.Sub(accrual.Last)
+	if elapsed <= 0 {
+		return 0, accrual.Remainder, nil
+	}
+
+	// Rate × elapsed / period, with remainder of previous accrual added to
+	// numerator.
+	hi, lo := bits.Mul64(accrual.Rate.Uint64(), uint64(elapsed))
+
+	var carry uint64
+	lo, carry = bits.Add64(lo, accrual.Remainder, 0)
+	hi += carry
+
+	period := uint64(accrual.Per)
+	if hi >= period {
+		return 0, 0, accrual.overflow(now)
+	}
+
+	owed, remainder := bits.Div64(hi, lo, period)
+	if owed >= Max {
+		return 0, 0, accrual.overflow(now)
+	}
+
+	return Decimal(owed), remainder, nil
+}
+
+func (accrual Accrual) overflow(now time.Time) error {
+	return fmt.Errorf(
+		"decimal type can't hold amount accrued at %s per %s from %s to %s",
+		accrual.Rate.String(),
+		accrual.Per,
+		accrual.Last.Format(time.RFC3339Nano),
+		now.Format(time.RFC3339Nano),
+	)
+}
@@ -0,0 +1,96 @@
+package decimal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccrual_Accrue_ComputesExactAmount(t *testing.T) {
+	test := assert.New(t)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	accrual := Accrual{
+		Rate: Must(FromString("10.0")),
+		Per:  24 * time.Hour,
+		Last: start,
+	}
+
+	owed, err := accrual.Accrue(start.Add(6 * time.Hour))
+	test.NoError(err)
+	test.Equal("2.50000000", owed.String())
+	test.Equal(start.Add(6*time.Hour), accrual.Last)
+	test.Equal(uint64(0), accrual.Remainder)
+}
+
+func TestAccrual_Accrue_CarriesRemainder(t *testing.T) {
+	test := assert.New(t)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 0.00000001 per 3 seconds
+	accrual := Accrual{Rate: 1, Per: 3 * time.Second, Last: start}
+
+	var total Decimal
+	for i := 1; i <= 7; i++ {
+		owed, err := accrual.Accrue(start.Add(time.Duration(i) * time.Second))
+		test.NoError(err)
+
+		total += owed
+	}
+
+	test.Equal(Decimal(2), total)
+	test.Equal(uint64(time.Second), accrual.Remainder)
+}
+
+func TestAccrual_Accrue_IgnoresTimeZones(t *testing.T) {
+	test := assert.New(t)
+
+	zone := time.FixedZone("UTC+3", 3*60*60)
+	start := time.Date(2020, 1, 1, 3, 0, 0, 0, zone)
+
+	accrual := Accrual{
+		Rate: Must(FromString("24.0")),
+		Per:  24 * time.Hour,
+		Last: start,
+	}
+
+	owed, err := accrual.Owed(time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC))
+	test.NoError(err)
+	test.Equal("1.00000000", owed.String())
+	test.Equal(start, accrual.Last)
+}
+
+func TestAccrual_Accrue_OwesNothingBeforeLast(t *testing.T) {
+	test := assert.New(t)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	accrual := Accrual{Rate: 1, Per: 3 * time.Second, Last: start, Remainder: 5}
+
+	owed, err := accrual.Accrue(start.Add(-time.Hour))
+	test.NoError(err)
+	test.Equal(Decimal(0), owed)
+	test.Equal(start, accrual.Last)
+	test.Equal(uint64(5), accrual.Remainder)
+}
+
+func TestAccrual_Accrue_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	accrual := Accrual{
+		Rate: Must(FromString("99999999999.0")),
+		Per:  time.Second,
+		Last: start,
+	}
+
+	_, err := accrual.Accrue(start.Add(2 * time.Second))
+	test.Error(err)
+	test.Contains(err.Error(), "can't hold amount accrued")
+	test.Equal(start, accrual.Last)
+
+	_, err = (&Accrual{Rate: 1, Last: start}).Accrue(start.Add(time.Second))
+	test.Error(err)
+	test.Contains(err.Error(), "period")
+}
@@ -0,0 +1,90 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// Allocate splits current value into n parts which sum exactly to it. Parts
+// differ by at most 0.00000001: indivisible remainder is distributed one
+// unit each to first parts, so allocation is deterministic. Method will
+// return error if n is not positive.
+//
+// Example:
+//	decimal.Scan("1.0")
+//	decimal.Allocate(3) // will return 0.33333334, 0.33333333, 0.33333333
+func (decimal Decimal) Allocate(n int) ([]Decimal, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf(
+			"number of parts should be positive: %d", n,
+		)
+	}
+
+	share := decimal / Decimal(n)
+	remainder := int(decimal % Decimal(n))
+
+	parts := make([]Decimal, n)
+	for i := range parts {
+		parts[i] = share
+		if i < remainder {
+			parts[i]++
+		}
+	}
+
+	return parts, nil
+}
+
+// AllocateByWeights splits current value into parts proportional to given
+// weights, which sum exactly to it, e.g. revenue share. It uses largest
+// remainder method: every part is rounded down and indivisible remainder is
+// distributed one unit each to parts with largest discarded fractions, ties
+// going to earlier parts. Method will return error if weights are empty or
+// all of them are zero.
+//
+// Example:
+//	decimal.Scan("1.0")
+//	decimal.AllocateByWeights([]uint64{1, 1, 1}) // 0.33333334, 0.33333333, 0.33333333
+func (decimal Decimal) AllocateByWeights(weights []uint64) ([]Decimal, error) {
+	var total big.Int
+	for _, weight := range weights {
+		total.Add(&total, new(big.Int).SetUint64(weight))
+	}
+
+	if total.Sign() == 0 {
+		return nil, fmt.Errorf("allocation needs at least one non-zero weight")
+	}
+
+	parts := make([]Decimal, len(weights))
+	remainders := make([]big.Int, len(weights))
+
+	allocated := Decimal(0)
+	value := bigDecimal(decimal)
+
+	for i, weight := range weights {
+		var share big.Int
+		share.Mul(value, new(big.Int).SetUint64(weight))
+		share.QuoRem(&share, &total, &remainders[i])
+
+		// Share never exceeds current value, so it fits.
+		parts[i] = Decimal(share.Uint64())
+		allocated += parts[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]].Cmp(&remainders[order[j]]) > 0
+	})
+
+	// Leftover is less than number of weights, since every part lost less
+	// than single unit.
+	for _, i := range order[:decimal-allocated] {
+		parts[i]++
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,86 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_Allocate_PreservesSum(t *testing.T) {
+	test := assert.New(t)
+
+	parts, err := Must(FromString("1.0")).Allocate(3)
+	test.NoError(err)
+	test.Equal([]Decimal{33333334, 33333333, 33333333}, parts)
+
+	parts, err = Decimal(2).Allocate(5)
+	test.NoError(err)
+	test.Equal([]Decimal{1, 1, 0, 0, 0}, parts)
+
+	parts, err = Decimal(Max - 1).Allocate(7)
+	test.NoError(err)
+	test.Equal(Decimal(Max-1), Must(Sum(parts...)))
+
+	parts, err = Decimal(42).Allocate(1)
+	test.NoError(err)
+	test.Equal([]Decimal{42}, parts)
+}
+
+func TestDecimal_Allocate_InvalidParts(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Decimal(42).Allocate(0)
+	test.Error(err)
+
+	_, err = Decimal(42).Allocate(-1)
+	test.Error(err)
+}
+
+func TestDecimal_AllocateByWeights_LargestRemainder(t *testing.T) {
+	test := assert.New(t)
+
+	parts, err := Must(FromString("1.0")).AllocateByWeights([]uint64{1, 1, 1})
+	test.NoError(err)
+	test.Equal([]Decimal{33333334, 33333333, 33333333}, parts)
+
+	// 10 units by 1:2:3 are 1.67, 3.33 and 5. Largest remainder goes to
+	// first part.
+	parts, err = Decimal(10).AllocateByWeights([]uint64{1, 2, 3})
+	test.NoError(err)
+	test.Equal([]Decimal{2, 3, 5}, parts)
+
+	// 7 units by 1:3:3 are 1, 3 and 3 exactly.
+	parts, err = Decimal(7).AllocateByWeights([]uint64{1, 3, 3})
+	test.NoError(err)
+	test.Equal([]Decimal{1, 3, 3}, parts)
+
+	parts, err = Decimal(5).AllocateByWeights([]uint64{0, 1, 0})
+	test.NoError(err)
+	test.Equal([]Decimal{0, 5, 0}, parts)
+}
+
+func TestDecimal_AllocateByWeights_PreservesSum(t *testing.T) {
+	test := assert.New(t)
+
+	max := ^uint64(0)
+
+	parts, err := Decimal(Max - 1).AllocateByWeights([]uint64{max, max, 1, 7})
+	test.NoError(err)
+	test.Len(parts, 4)
+	test.Equal(Decimal(Max-1), Must(Sum(parts...)))
+	test.Equal(parts[1]+1, parts[0])
+
+	parts, err = Must(FromString("100.0")).AllocateByWeights([]uint64{3, 7, 11, 13, 17})
+	test.NoError(err)
+	test.Equal(Must(FromString("100.0")), Must(Sum(parts...)))
+}
+
+func TestDecimal_AllocateByWeights_InvalidWeights(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Decimal(42).AllocateByWeights(nil)
+	test.Error(err)
+
+	_, err = Decimal(42).AllocateByWeights([]uint64{0, 0})
+	test.Error(err)
+}
@@ -0,0 +1,72 @@
+package decimal
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Atomic is Decimal which can be updated from many goroutines without
+// locking, e.g. gauge of open interest. Zero value is 0. Atomic must not be
+// copied after first use.
+type Atomic struct {
+	// value is first field, so it's 64-bit aligned on 32-bit platforms.
+	value uint64
+}
+
+// Load returns current value.
+func (value *Atomic) Load() Decimal {
+	return Decimal(atomic.LoadUint64(&value.value))
+}
+
+// Store sets current value.
+func (value *Atomic) Store(number Decimal) {
+	atomic.StoreUint64(&value.value, number.Uint64())
+}
+
+// CompareAndSwap sets value to new one if it's equal to old one and reports
+// whether it was set.
+func (value *Atomic) CompareAndSwap(old, new Decimal) bool {
+	return atomic.CompareAndSwapUint64(&value.value, old.Uint64(), new.Uint64())
+}
+
+// Add adds given delta to value and returns new value. Method will return
+// error and leave value unchanged if result exceeds maximum value of
+// Decimal type.
+func (value *Atomic) Add(delta Decimal) (Decimal, error) {
+	for {
+		old := value.Load()
+
+		sum, ok := add(old, delta)
+		if !ok {
+			return 0, fmt.Errorf(
+				"decimal type can't hold result of addition: %s + %s",
+				old.String(),
+				delta.String(),
+			)
+		}
+
+		if value.CompareAndSwap(old, sum) {
+			return sum, nil
+		}
+	}
+}
+
+// Sub subtracts given delta from value and returns new value. Method will
+// return error and leave value unchanged if delta is greater than value.
+func (value *Atomic) Sub(delta Decimal) (Decimal, error) {
+	for {
+		old := value.Load()
+
+		if delta > old {
+			return 0, fmt.Errorf(
+				"decimal type can't hold negative result of subtraction: %s - %s",
+				old.String(),
+				delta.String(),
+			)
+		}
+
+		if value.CompareAndSwap(old, old-delta) {
+			return old - delta, nil
+		}
+	}
+}
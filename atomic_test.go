@@ -0,0 +1,72 @@
+package decimal
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtomic_LoadStore(t *testing.T) {
+	test := assert.New(t)
+
+	var value Atomic
+	test.Equal(Decimal(0), value.Load())
+
+	value.Store(Must(FromString("1.5")))
+	test.Equal(Must(FromString("1.5")), value.Load())
+
+	test.False(value.CompareAndSwap(0, 42))
+	test.True(value.CompareAndSwap(Must(FromString("1.5")), 42))
+	test.Equal(Decimal(42), value.Load())
+}
+
+func TestAtomic_Add_Concurrent(t *testing.T) {
+	test := assert.New(t)
+
+	var (
+		value Atomic
+		group sync.WaitGroup
+	)
+
+	for i := 0; i < 16; i++ {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			for j := 0; j < 1000; j++ {
+				_, err := value.Add(Must(FromString("0.00000003")))
+				test.NoError(err)
+
+				_, err = value.Sub(Must(FromString("0.00000001")))
+				test.NoError(err)
+			}
+		}()
+	}
+
+	group.Wait()
+
+	test.Equal(Decimal(16*1000*2), value.Load())
+}
+
+func TestAtomic_RejectsOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	var value Atomic
+	value.Store(Decimal(Max - 1))
+
+	_, err := value.Add(1)
+	test.Error(err)
+	test.Equal(Decimal(Max-1), value.Load())
+
+	value.Store(1)
+
+	_, err = value.Sub(2)
+	test.Error(err)
+	test.Equal(Decimal(1), value.Load())
+
+	actual, err := value.Sub(1)
+	test.NoError(err)
+	test.Equal(Decimal(0), actual)
+}
@@ -0,0 +1,321 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// BigDecimal is arbitrary-precision signed decimal number, which equals
+// coefficient × 10^exponent. It's meant for intermediate computations which
+// exceed range or precision of Decimal type: they are done exactly and then
+// narrowed back with explicit rounding step, see BigDecimal.Decimal().
+//
+// Zero value is 0. BigDecimal values are immutable, methods return new
+// values.
+//
+// Example:
+//
+//	notional := price.Big().Mul(quantity.Big()).Mul(rate.Big())
+//	result, err := notional.Decimal(decimal.RoundHalfEven)
+type BigDecimal struct {
+	coefficient *big.Int
+	exponent    int
+}
+
+// NewBigDecimal returns BigDecimal equal to coefficient × 10^exponent.
+// Given coefficient is copied.
+func NewBigDecimal(coefficient *big.Int, exponent int) BigDecimal {
+	return BigDecimal{
+		coefficient: new(big.Int).Set(coefficient),
+		exponent:    exponent,
+	}
+}
+
+// ParseBigDecimal returns BigDecimal parsed from plain decimal notation
+// with optional sign, e.g. "-123.456789012345678901". Decimal point and
+// fractional part are optional.
+func ParseBigDecimal(value string) (BigDecimal, error) {
+	text := value
+	if strings.HasPrefix(text, "-") || strings.HasPrefix(text, "+") {
+		text = text[1:]
+	}
+
+	integer, fractional := text, ""
+	period := strings.IndexByte(text, '.')
+	if period >= 0 {
+		integer, fractional = text[:period], text[period+1:]
+	}
+
+	if !isDigits(integer) || (period >= 0 && !isDigits(fractional)) {
+		return BigDecimal{}, fmt.Errorf("big decimal can't be parsed: %q", value)
+	}
+
+	coefficient, _ := new(big.Int).SetString(integer+fractional, 10)
+	if value[0] == '-' {
+		coefficient.Neg(coefficient)
+	}
+
+	return BigDecimal{coefficient: coefficient, exponent: -len(fractional)}, nil
+}
+
+// Big returns value as BigDecimal.
+func (decimal Decimal) Big() BigDecimal {
+	return BigDecimal{
+		coefficient: bigDecimal(decimal),
+		exponent:    -MaxPointsFractional,
+	}
+}
+
+// Big returns value as BigDecimal.
+func (signed Signed) Big() BigDecimal {
+	value := signed.magnitude.Big()
+	if signed.negative {
+		value.coefficient.Neg(value.coefficient)
+	}
+
+	return value
+}
+
+// Coefficient returns copy of coefficient of value.
+func (value BigDecimal) Coefficient() *big.Int {
+	return new(big.Int).Set(value.int())
+}
+
+// Exponent returns exponent of value.
+func (value BigDecimal) Exponent() int {
+	return value.exponent
+}
+
+// int returns coefficient of value, which must not be modified.
+func (value BigDecimal) int() *big.Int {
+	if value.coefficient == nil {
+		return new(big.Int)
+	}
+
+	return value.coefficient
+}
+
+// Sign returns -1, 0 or 1 if value is negative, zero or positive.
+func (value BigDecimal) Sign() int {
+	return value.int().Sign()
+}
+
+// Neg returns value with opposite sign.
+func (value BigDecimal) Neg() BigDecimal {
+	return BigDecimal{
+		coefficient: new(big.Int).Neg(value.int()),
+		exponent:    value.exponent,
+	}
+}
+
+// rescaled returns coefficient of value at given exponent, which must not
+// be greater than exponent of value.
+func (value BigDecimal) rescaled(exponent int) *big.Int {
+	coefficient := new(big.Int).Set(value.int())
+	if exponent == value.exponent {
+		return coefficient
+	}
+
+	return coefficient.Mul(coefficient, pow10(value.exponent-exponent))
+}
+
+// pow10 returns 10^n.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// align returns coefficients of both values at common exponent.
+func align(a, b BigDecimal) (*big.Int, *big.Int, int) {
+	exponent := a.exponent
+	if b.exponent < exponent {
+		exponent = b.exponent
+	}
+
+	return a.rescaled(exponent), b.rescaled(exponent), exponent
+}
+
+// Add returns exact sum of current value and given addend.
+func (value BigDecimal) Add(addend BigDecimal) BigDecimal {
+	a, b, exponent := align(value, addend)
+
+	return BigDecimal{coefficient: a.Add(a, b), exponent: exponent}
+}
+
+// Sub returns exact difference of current value and given subtrahend.
+func (value BigDecimal) Sub(subtrahend BigDecimal) BigDecimal {
+	a, b, exponent := align(value, subtrahend)
+
+	return BigDecimal{coefficient: a.Sub(a, b), exponent: exponent}
+}
+
+// Mul returns exact product of current value and given multiplier.
+func (value BigDecimal) Mul(multiplier BigDecimal) BigDecimal {
+	return BigDecimal{
+		coefficient: new(big.Int).Mul(value.int(), multiplier.int()),
+		exponent:    value.exponent + multiplier.exponent,
+	}
+}
+
+// Quo returns current value divided by given divisor and rounded to given
+// number of digits after decimal point with given mode. Rounding modes are
+// applied to magnitude, e.g. RoundDown rounds towards zero. Method will
+// return error if divisor is zero.
+func (value BigDecimal) Quo(divisor BigDecimal, places int, mode RoundingMode) (BigDecimal, error) {
+	if divisor.Sign() == 0 {
+		return BigDecimal{}, fmt.Errorf(
+			"big decimal can't be divided by zero: %s / 0", value.String(),
+		)
+	}
+
+	// value / divisor = (a × 10^shift) / b × 10^-places
+	shift := places + value.exponent - divisor.exponent
+
+	numerator := new(big.Int).Set(value.int())
+	denominator := new(big.Int).Set(divisor.int())
+
+	if shift >= 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+
+	return BigDecimal{
+		coefficient: divideSigned("big.quo", mode, numerator, denominator, places),
+		exponent:    -places,
+	}, nil
+}
+
+// Round returns value rounded to given number of digits after decimal point
+// with given mode. Rounding modes are applied to magnitude.
+func (value BigDecimal) Round(places int, mode RoundingMode) BigDecimal {
+	if -value.exponent <= places {
+		return BigDecimal{coefficient: value.int(), exponent: value.exponent}
+	}
+
+	return BigDecimal{
+		coefficient: divideSigned(
+			"big.round",
+			mode,
+			value.int(),
+			pow10(-value.exponent-places),
+			places,
+		),
+		exponent: -places,
+	}
+}
+
+// divideSigned returns numerator / denominator rounded with given mode
+// applied to magnitude of quotient, which has given number of places, and
+// reports discarded part of value as inexact operation.
+func divideSigned(
+	op string,
+	mode RoundingMode,
+	numerator, denominator *big.Int,
+	places int,
+) *big.Int {
+	negative := numerator.Sign()*denominator.Sign() < 0
+
+	quotient := mode.applyPlaces(
+		op,
+		new(big.Int).Abs(numerator),
+		new(big.Int).Abs(denominator),
+		places,
+	)
+
+	if negative {
+		quotient.Neg(quotient)
+	}
+
+	return quotient
+}
+
+// Cmp returns -1, 0 or 1 if current value is less than, equal to or greater
+// than other value.
+func (value BigDecimal) Cmp(other BigDecimal) int {
+	a, b, _ := align(value, other)
+
+	return a.Cmp(b)
+}
+
+// Decimal returns value rounded to 8 places with given mode as Decimal.
+// Method will return error if value is negative or can't be stored in
+// Decimal type.
+func (value BigDecimal) Decimal(mode RoundingMode) (Decimal, error) {
+	if value.Sign() < 0 {
+		return 0, fmt.Errorf(
+			"decimal type can't hold negative value: %s", value.String(),
+		)
+	}
+
+	rounded := value.Round(MaxPointsFractional, mode)
+
+	result, ok := fromBig(rounded.rescaled(-MaxPointsFractional))
+	if !ok {
+		return 0, fmt.Errorf("decimal type can't hold value: %s", value.String())
+	}
+
+	return result, nil
+}
+
+// String returns value in plain decimal notation with all digits of
+// coefficient, e.g. "-1.2300" for coefficient -12300 and exponent -4.
+func (value BigDecimal) String() string {
+	coefficient := value.int()
+
+	digits := new(big.Int).Abs(coefficient).String()
+
+	switch {
+	case value.exponent > 0 && coefficient.Sign() != 0:
+		digits += strings.Repeat("0", value.exponent)
+
+	case value.exponent < 0:
+		places := -value.exponent
+		if len(digits) <= places {
+			digits = strings.Repeat("0", places-len(digits)+1) + digits
+		}
+
+		digits = digits[:len(digits)-places] + "." + digits[len(digits)-places:]
+	}
+
+	if coefficient.Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+// MarshalText returns string representation as []byte type.
+// Used in json marshaling/unmarshaling.
+func (value BigDecimal) MarshalText() ([]byte, error) {
+	return []byte(value.String()), nil
+}
+
+// UnmarshalText calls ParseBigDecimal() to read BigDecimal type.
+// Used in json marshaling/unmarshaling.
+func (value *BigDecimal) UnmarshalText(data []byte) error {
+	parsed, err := ParseBigDecimal(string(data))
+	if err != nil {
+		return err
+	}
+
+	*value = parsed
+
+	return nil
+}
+
+// isDigits reports whether given string is non-empty and contains only
+// decimal digits.
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,149 @@
+package decimal
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustBig(value string) BigDecimal {
+	number, err := ParseBigDecimal(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return number
+}
+
+func TestParseBigDecimal_PlainNotation(t *testing.T) {
+	test := assert.New(t)
+
+	for value, expected := range map[string]string{
+		"123.456789012345678901":       "123.456789012345678901",
+		"-1.2300":                      "-1.2300",
+		"+7":                           "7",
+		"0.0":                          "0.0",
+		"-0.001":                       "-0.001",
+		"99999999999999999999999999.5": "99999999999999999999999999.5",
+	} {
+		actual, err := ParseBigDecimal(value)
+		test.NoError(err, value)
+		test.Equal(expected, actual.String(), value)
+	}
+
+	for _, value := range []string{"", "-", "+-1", "--1", ".5", "5.", "1e5", "1.2.3"} {
+		_, err := ParseBigDecimal(value)
+		test.Error(err, value)
+	}
+}
+
+func TestBigDecimal_String(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("0", BigDecimal{}.String())
+	test.Equal("12300", NewBigDecimal(big.NewInt(123), 2).String())
+	test.Equal("0", NewBigDecimal(big.NewInt(0), 2).String())
+	test.Equal("-0.00123", NewBigDecimal(big.NewInt(-123), -5).String())
+	test.Equal("1.50000000", Must(FromString("1.5")).Big().String())
+	test.Equal("-1.50000000", mustSigned("-1.5").Big().String())
+}
+
+func TestBigDecimal_Arithmetic(t *testing.T) {
+	test := assert.New(t)
+
+	a := mustBig("99999999999.99999999")
+	b := mustBig("-0.000000001")
+
+	test.Equal("99999999999.999999989", a.Add(b).String())
+	test.Equal("99999999999.999999991", a.Sub(b).String())
+	test.Equal("-99.99999999999999999", a.Mul(b).String())
+	test.Equal("9999999999999999998000.0000000000000001", a.Mul(a).String())
+
+	test.Equal(1, a.Cmp(b))
+	test.Equal(-1, b.Cmp(a))
+	test.Equal(0, mustBig("1.50").Cmp(mustBig("1.5")))
+	test.Equal(0, BigDecimal{}.Cmp(mustBig("0.000")))
+
+	test.Equal(-1, b.Sign())
+	test.Equal(0, BigDecimal{}.Sign())
+	test.Equal("0.000000001", b.Neg().String())
+
+	coefficient := a.Coefficient()
+	coefficient.SetInt64(0)
+	test.Equal("99999999999.99999999", a.String())
+	test.Equal(-8, a.Exponent())
+}
+
+func TestBigDecimal_Quo(t *testing.T) {
+	test := assert.New(t)
+
+	quotient, err := mustBig("1").Quo(mustBig("3"), 20, RoundHalfEven)
+	test.NoError(err)
+	test.Equal("0.33333333333333333333", quotient.String())
+
+	quotient, err = mustBig("-2").Quo(mustBig("3"), 2, RoundDown)
+	test.NoError(err)
+	test.Equal("-0.66", quotient.String())
+
+	quotient, err = mustBig("-2").Quo(mustBig("3"), 2, RoundUp)
+	test.NoError(err)
+	test.Equal("-0.67", quotient.String())
+
+	quotient, err = mustBig("12300.0").Quo(mustBig("0.0001"), 0, RoundDown)
+	test.NoError(err)
+	test.Equal("123000000", quotient.String())
+
+	_, err = mustBig("1").Quo(BigDecimal{}, 2, RoundDown)
+	test.Error(err)
+}
+
+func TestBigDecimal_Round(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("1.24", mustBig("1.235").Round(2, RoundHalfUp).String())
+	test.Equal("-1.24", mustBig("-1.235").Round(2, RoundHalfUp).String())
+	test.Equal("-1.23", mustBig("-1.235").Round(2, RoundDown).String())
+	test.Equal("1.5", mustBig("1.5").Round(2, RoundDown).String())
+}
+
+func TestBigDecimal_Decimal_Narrows(t *testing.T) {
+	test := assert.New(t)
+
+	value, err := mustBig("1.234567895").Decimal(RoundHalfEven)
+	test.NoError(err)
+	test.Equal("1.23456790", value.String())
+
+	value, err = NewBigDecimal(big.NewInt(15), 1).Decimal(RoundDown)
+	test.NoError(err)
+	test.Equal("150.00000000", value.String())
+
+	a := Must(FromString("99999999999.0")).Big()
+	quotient, err := a.Mul(a).Quo(a, 8, RoundDown)
+	test.NoError(err)
+
+	narrowed, err := quotient.Decimal(RoundDown)
+	test.NoError(err)
+	test.Equal("99999999999.00000000", narrowed.String())
+
+	_, err = a.Mul(a).Decimal(RoundDown)
+	test.Error(err)
+
+	_, err = mustBig("-0.000000001").Decimal(RoundDown)
+	test.Error(err)
+}
+
+func TestBigDecimal_JSON(t *testing.T) {
+	test := assert.New(t)
+
+	data, err := json.Marshal(mustBig("-123456789012.345678901"))
+	test.NoError(err)
+	test.Equal(`"-123456789012.345678901"`, string(data))
+
+	var value BigDecimal
+	test.NoError(json.Unmarshal(data, &value))
+	test.Equal("-123456789012.345678901", value.String())
+
+	test.Error(json.Unmarshal([]byte(`"1.2.3"`), &value))
+}
@@ -0,0 +1,159 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+)
+
+// Protocol buffers wire format of DecimalBlock message from block.proto.
+const (
+	blockValuesTag         = 1<<3 | 2 // packed repeated fixed64
+	blockUnpackedValuesTag = 1<<3 | 1 // unpacked repeated fixed64
+	blockChecksumTag       = 2<<3 | 5 // fixed32
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// MarshalBlock returns given values encoded as DecimalBlock protocol buffers
+// message defined in block.proto: values are packed as fixed64 units, so
+// vector of n values takes 8 × n bytes plus few bytes of framing, and
+// checksum of values is added to detect corruption.
+func MarshalBlock(values []Decimal) []byte {
+	size := 8 * len(values)
+
+	data := make([]byte, 0, size+binary.MaxVarintLen64+6)
+	if len(values) > 0 {
+		data = append(data, blockValuesTag)
+		data = binary.AppendUvarint(data, uint64(size))
+	}
+
+	start := len(data)
+	for _, value := range values {
+		data = binary.LittleEndian.AppendUint64(data, value.Uint64())
+	}
+	checksum := crc32.Checksum(data[start:], castagnoli)
+
+	data = append(data, blockChecksumTag)
+	data = binary.LittleEndian.AppendUint32(data, checksum)
+
+	return data
+}
+
+// UnmarshalBlock returns values decoded from DecimalBlock protocol buffers
+// message. It accepts both packed and unpacked values, as protocol buffers
+// parsers do, treats missing checksum as zero, since proto3 encoders omit
+// fields with zero value, and skips unknown fields, e.g. added by newer
+// version of block.proto. Function will return error if message is
+// malformed, checksum doesn't match values or any value can't be stored in
+// Decimal type.
+func UnmarshalBlock(data []byte) ([]Decimal, error) {
+	var (
+		payload  []byte
+		checksum uint32
+	)
+
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, fmt.Errorf("decimal block has malformed field tag")
+		}
+		data = data[n:]
+
+		switch tag {
+		case blockValuesTag:
+			size, n := binary.Uvarint(data)
+			if n <= 0 || size%8 != 0 || size > uint64(len(data)-n) {
+				return nil, fmt.Errorf("decimal block has malformed values")
+			}
+
+			payload = append(payload, data[n:n+int(size)]...)
+			data = data[n+int(size):]
+
+		case blockUnpackedValuesTag:
+			if len(data) < 8 {
+				return nil, fmt.Errorf("decimal block has malformed values")
+			}
+
+			payload = append(payload, data[:8]...)
+			data = data[8:]
+
+		case blockChecksumTag:
+			if len(data) < 4 {
+				return nil, fmt.Errorf("decimal block has malformed checksum")
+			}
+
+			checksum = binary.LittleEndian.Uint32(data)
+			data = data[4:]
+
+		default:
+			size, err := skipField(tag, data)
+			if err != nil {
+				return nil, err
+			}
+
+			data = data[size:]
+		}
+	}
+
+	if actual := crc32.Checksum(payload, castagnoli); actual != checksum {
+		return nil, fmt.Errorf(
+			"decimal block checksum %08x doesn't match values, expected %08x",
+			checksum,
+			actual,
+		)
+	}
+
+	values := make([]Decimal, len(payload)/8)
+	for i := range values {
+		units := binary.LittleEndian.Uint64(payload[8*i:])
+		if units >= Max {
+			return nil, fmt.Errorf(
+				"decimal type can't hold %d units of 0.00000001", units,
+			)
+		}
+
+		values[i] = Decimal(units)
+	}
+
+	return values, nil
+}
+
+// skipField returns size of value of unknown field with given tag at start
+// of given data. Function will return error if wire type is unsupported or
+// value is truncated.
+func skipField(tag uint64, data []byte) (int, error) {
+	size := -1
+
+	switch tag & 7 {
+	case 0: // varint
+		if _, n := binary.Uvarint(data); n > 0 {
+			size = n
+		}
+
+	case 1: // fixed64
+		size = 8
+
+	case 2: // length-delimited
+		length, n := binary.Uvarint(data)
+		if n > 0 && length <= uint64(len(data)-n) {
+			size = n + int(length)
+		}
+
+	case 5: // fixed32
+		size = 4
+
+	default:
+		return 0, fmt.Errorf(
+			"decimal block has field %d of unsupported wire type %d",
+			tag>>3,
+			tag&7,
+		)
+	}
+
+	if size < 0 || size > len(data) {
+		return 0, fmt.Errorf("decimal block has malformed field %d", tag>>3)
+	}
+
+	return size, nil
+}
@@ -0,0 +1,121 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalBlock_EncodesPackedFixed64(t *testing.T) {
+	test := assert.New(t)
+
+	data := MarshalBlock([]Decimal{1, 2})
+
+	test.Equal([]byte{0x0a, 16}, data[:2])
+	test.Equal(uint64(1), binary.LittleEndian.Uint64(data[2:]))
+	test.Equal(uint64(2), binary.LittleEndian.Uint64(data[10:]))
+	test.Equal(byte(0x15), data[18])
+	test.Equal(
+		crc32.Checksum(data[2:18], crc32.MakeTable(crc32.Castagnoli)),
+		binary.LittleEndian.Uint32(data[19:]),
+	)
+	test.Len(data, 23)
+}
+
+func TestUnmarshalBlock_DecodesMarshaledValues(t *testing.T) {
+	test := assert.New(t)
+
+	for _, values := range [][]Decimal{
+		{},
+		{0},
+		{Must(FromString("1.5")), Must(FromString("99999999999.99999999")), 1},
+	} {
+		actual, err := UnmarshalBlock(MarshalBlock(values))
+		test.NoError(err)
+		test.Equal(values, actual)
+	}
+}
+
+func TestUnmarshalBlock_AcceptsUnpackedValues(t *testing.T) {
+	test := assert.New(t)
+
+	packed := MarshalBlock([]Decimal{7})
+
+	data := []byte{0x09}
+	data = append(data, packed[2:10]...)
+	data = append(data, packed[10:]...)
+
+	actual, err := UnmarshalBlock(data)
+	test.NoError(err)
+	test.Equal([]Decimal{7}, actual)
+}
+
+func TestUnmarshalBlock_TreatsMissingChecksumAsZero(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := UnmarshalBlock(nil)
+	test.NoError(err)
+	test.Empty(actual)
+
+	// Checksum of this value is zero, so proto3 encoder omits it.
+	value := Decimal(3753373677)
+
+	data := MarshalBlock([]Decimal{value})
+	test.Equal([]byte{0x15, 0, 0, 0, 0}, data[10:])
+
+	actual, err = UnmarshalBlock(data[:10])
+	test.NoError(err)
+	test.Equal([]Decimal{value}, actual)
+}
+
+func TestUnmarshalBlock_SkipsUnknownFields(t *testing.T) {
+	test := assert.New(t)
+
+	values := []Decimal{Must(FromString("1.5")), 2}
+
+	var data []byte
+	data = append(data, 0x18, 0xac, 0x02)             // field 3, varint 300
+	data = append(data, 0x21, 1, 2, 3, 4, 5, 6, 7, 8) // field 4, fixed64
+	data = append(data, MarshalBlock(values)...)      // fields 1 and 2
+	data = append(data, 0x2a, 3, 'a', 'b', 'c')       // field 5, bytes
+	data = append(data, 0x35, 1, 2, 3, 4)             // field 6, fixed32
+	data = append(data, 0x82, 0x01, 0)                // field 16, empty bytes
+
+	actual, err := UnmarshalBlock(data)
+	test.NoError(err)
+	test.Equal(values, actual)
+}
+
+func TestUnmarshalBlock_ReturnsErrorOnCorruption(t *testing.T) {
+	test := assert.New(t)
+
+	data := MarshalBlock([]Decimal{1, 2})
+
+	corrupted := append([]byte(nil), data...)
+	corrupted[2]++
+	_, err := UnmarshalBlock(corrupted)
+	test.Error(err)
+	test.Contains(err.Error(), "checksum")
+
+	_, err = UnmarshalBlock(data[:18])
+	test.Error(err)
+	test.Contains(err.Error(), "checksum 00000000 doesn't match")
+
+	_, err = UnmarshalBlock(data[:10])
+	test.Error(err)
+	test.Contains(err.Error(), "malformed values")
+
+	_, err = UnmarshalBlock(append([]byte{0x1b}, data...))
+	test.Error(err)
+	test.Contains(err.Error(), "field 3 of unsupported wire type 3")
+
+	_, err = UnmarshalBlock(append(append([]byte(nil), data...), 0x1a, 5, 1))
+	test.Error(err)
+	test.Contains(err.Error(), "malformed field 3")
+
+	_, err = UnmarshalBlock(MarshalBlock([]Decimal{Decimal(Max)}))
+	test.Error(err)
+	test.Contains(err.Error(), "can't hold")
+}
@@ -0,0 +1,68 @@
+package decimal
+
+import (
+	"fmt"
+	"hash/crc32"
+	"strconv"
+)
+
+// Level is price level of order book.
+type Level struct {
+	Price    Decimal
+	Quantity Decimal
+}
+
+// BookChecksum returns CRC32 checksum of given order book levels as used by
+// exchange feeds to verify integrity of book snapshots: for every level
+// price and quantity are formatted with 8 digits after decimal point,
+// decimal point and leading zeros are removed and resulting strings are
+// concatenated in given order.
+//
+// Levels should be passed in order expected by feed, e.g. top 10 asks from
+// lowest price followed by top 10 bids from highest price. Use
+// BookChecksumPlaces() if feed formats levels with instrument precision.
+func BookChecksum(levels []Level) uint32 {
+	return BookChecksumPlaces(levels, MaxPointsFractional, MaxPointsFractional)
+}
+
+// BookChecksumPlaces returns checksum like BookChecksum() does, but formats
+// prices and quantities with given number of digits after decimal point.
+// Extra digits are discarded, so levels should already be at feed
+// precision. It panics if number of places is not in range from 0 to 8.
+//
+// Example:
+//	// price 0.05005 and quantity 0.00000500 contribute "5005500"
+//	decimal.BookChecksumPlaces(levels, 5, 8)
+func BookChecksumPlaces(levels []Level, pricePlaces, quantityPlaces int) uint32 {
+	for _, places := range []int{pricePlaces, quantityPlaces} {
+		if places < 0 || places > MaxPointsFractional {
+			panic(fmt.Sprintf(
+				"number of places should be from 0 to %d: %d",
+				MaxPointsFractional,
+				places,
+			))
+		}
+	}
+
+	var buffer []byte
+	for _, level := range levels {
+		buffer = appendChecksumDigits(buffer, level.Price, pricePlaces)
+		buffer = appendChecksumDigits(buffer, level.Quantity, quantityPlaces)
+	}
+
+	return crc32.ChecksumIEEE(buffer)
+}
+
+// appendChecksumDigits appends digits of value with given number of places
+// without decimal point and leading zeros, so zero appends nothing.
+func appendChecksumDigits(buffer []byte, value Decimal, places int) []byte {
+	integer, fractional := value.Split()
+
+	digits := integer*powers[places] +
+		fractional/powers[MaxPointsFractional-places]
+	if digits == 0 {
+		return buffer
+	}
+
+	return strconv.AppendUint(buffer, digits, 10)
+}
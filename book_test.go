@@ -0,0 +1,47 @@
+package decimal
+
+import (
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookChecksum_ConcatenatesLevelsWithoutLeadingZeroes(t *testing.T) {
+	test := assert.New(t)
+
+	levels := []Level{
+		{Price: Must(FromString("0.05005")), Quantity: Must(FromString("0.000005"))},
+		{Price: Must(FromString("1234.5")), Quantity: Must(FromString("10.0"))},
+	}
+
+	test.Equal(
+		crc32.ChecksumIEEE([]byte("5005000500"+"1234500000001000000000")),
+		BookChecksum(levels),
+	)
+	test.Equal(
+		crc32.ChecksumIEEE([]byte("5005500"+"123450000"+"1000000000")),
+		BookChecksumPlaces(levels, 5, 8),
+	)
+}
+
+func TestBookChecksumPlaces_SkipsZeroes(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal(
+		crc32.ChecksumIEEE([]byte("1")),
+		BookChecksumPlaces([]Level{{Price: Must(FromString("1.0"))}}, 0, 0),
+	)
+	test.Equal(crc32.ChecksumIEEE(nil), BookChecksum(nil))
+}
+
+func TestBookChecksumPlaces_PanicsOnInvalidPlaces(t *testing.T) {
+	test := assert.New(t)
+
+	test.Panics(func() {
+		BookChecksumPlaces(nil, 9, 8)
+	})
+	test.Panics(func() {
+		BookChecksumPlaces(nil, 8, -1)
+	})
+}
@@ -0,0 +1,138 @@
+package decimal
+
+import (
+	"fmt"
+	"math/bits"
+	"sync"
+	"time"
+)
+
+// Budget tracks amount which can be spent and refills it continuously up to
+// limit, e.g. daily withdrawal limit. Refilled amount is computed exactly:
+// parts of 0.00000001 which can't be credited yet are carried forward to
+// next refill instead of being lost.
+//
+// Budget is safe for concurrent use.
+//
+// Example:
+//	budget := decimal.NewBudget(decimal.Must(decimal.FromString("10.0")))
+//	budget.Refill(decimal.Must(decimal.FromString("10.0")), 24*time.Hour)
+//	budget.Spend(decimal.Must(decimal.FromString("2.5"))) // nil
+type Budget struct {
+	mutex sync.Mutex
+
+	limit     Decimal
+	available Decimal
+	accrual   Accrual
+}
+
+// NewBudget returns Budget with given limit which is fully available and
+// doesn't refill until Refill() is called.
+func NewBudget(limit Decimal) *Budget {
+	return &Budget{
+		limit:     limit,
+		available: limit,
+		accrual:   Accrual{Last: time.Now()},
+	}
+}
+
+// Refill sets rate at which budget is refilled: given amount per given
+// period. Amount accrued at previous rate is credited first and part of
+// 0.00000001 accrued but not yet credited is carried over to new rate. Zero
+// amount or non-positive period stops refilling and discards that part.
+func (budget *Budget) Refill(amount Decimal, per time.Duration) {
+	budget.refill(amount, per, time.Now())
+}
+
+// Spend deducts given amount from budget and returns error if amount
+// exceeds currently available budget. Budget is not changed on error.
+func (budget *Budget) Spend(amount Decimal) error {
+	return budget.spend(amount, time.Now())
+}
+
+// Available returns amount which can be spent right now.
+func (budget *Budget) Available() Decimal {
+	return budget.availableAt(time.Now())
+}
+
+// Limit returns maximum amount which budget can hold.
+func (budget *Budget) Limit() Decimal {
+	return budget.limit
+}
+
+func (budget *Budget) refill(amount Decimal, per time.Duration, now time.Time) {
+	budget.mutex.Lock()
+	defer budget.mutex.Unlock()
+
+	budget.accrue(now)
+
+	if amount == 0 || per <= 0 {
+		amount, per = 0, 0
+	}
+
+	// Remainder is fraction remainder/period of 0.00000001, so it's rescaled
+	// to new period, which always keeps it less than new period. It's zero if
+	// budget wasn't refilled.
+	remainder := budget.accrual.Remainder
+	switch {
+	case per == 0:
+		remainder = 0
+	case remainder != 0 && per != budget.accrual.Per:
+		hi, lo := bits.Mul64(remainder, uint64(per))
+		remainder, _ = bits.Div64(hi, lo, uint64(budget.accrual.Per))
+	}
+
+	budget.accrual.Rate = amount
+	budget.accrual.Per = per
+	budget.accrual.Remainder = remainder
+}
+
+func (budget *Budget) spend(amount Decimal, now time.Time) error {
+	budget.mutex.Lock()
+	defer budget.mutex.Unlock()
+
+	budget.accrue(now)
+
+	if amount > budget.available {
+		return fmt.Errorf(
+			"budget can't cover amount %s, available: %s",
+			amount.String(),
+			budget.available.String(),
+		)
+	}
+
+	budget.available -= amount
+
+	return nil
+}
+
+func (budget *Budget) availableAt(now time.Time) Decimal {
+	budget.mutex.Lock()
+	defer budget.mutex.Unlock()
+
+	budget.accrue(now)
+
+	return budget.available
+}
+
+// accrue credits amount refilled since last update. Caller must hold mutex.
+func (budget *Budget) accrue(now time.Time) {
+	if !now.After(budget.accrual.Last) {
+		return
+	}
+
+	if budget.accrual.Per == 0 || budget.available >= budget.limit {
+		budget.accrual.Last, budget.accrual.Remainder = now, 0
+		return
+	}
+
+	// Amount which can't be stored in Decimal type exceeds limit as well.
+	credit, err := budget.accrual.Accrue(now)
+	if err != nil || credit >= budget.limit-budget.available {
+		budget.available = budget.limit
+		budget.accrual.Last, budget.accrual.Remainder = now, 0
+		return
+	}
+
+	budget.available += credit
+}
@@ -0,0 +1,110 @@
+package decimal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudget_Spend_ReturnsErrorWhenExhausted(t *testing.T) {
+	test := assert.New(t)
+
+	budget := NewBudget(Must(FromString("10.0")))
+
+	test.NoError(budget.Spend(Must(FromString("7.5"))))
+
+	err := budget.Spend(Must(FromString("2.50000001")))
+	test.Error(err)
+	test.Contains(err.Error(), "can't cover")
+	test.Equal("2.50000000", budget.Available().String())
+}
+
+func TestBudget_Refill_CreditsExactAmount(t *testing.T) {
+	test := assert.New(t)
+
+	start := time.Now()
+	budget := NewBudget(Must(FromString("10.0")))
+	budget.accrual.Last = start
+
+	budget.refill(Must(FromString("10.0")), 24*time.Hour, start)
+	test.NoError(budget.spend(Must(FromString("10.0")), start))
+
+	test.Equal(
+		"5.00000000",
+		budget.availableAt(start.Add(12*time.Hour)).String(),
+	)
+	test.Equal(
+		"10.00000000",
+		budget.availableAt(start.Add(48*time.Hour)).String(),
+	)
+}
+
+func TestBudget_Refill_CarriesRemainder(t *testing.T) {
+	test := assert.New(t)
+
+	start := time.Now()
+	budget := NewBudget(Must(FromString("1.0")))
+	budget.accrual.Last = start
+
+	// 0.00000001 per 3 seconds
+	budget.refill(1, 3*time.Second, start)
+	test.NoError(budget.spend(Must(FromString("1.0")), start))
+
+	now := start
+	for i := 0; i < 3; i++ {
+		now = now.Add(time.Second)
+		budget.availableAt(now)
+	}
+
+	test.Equal(Decimal(1), budget.availableAt(now))
+}
+
+func TestBudget_Refill_StopsAtLimit(t *testing.T) {
+	test := assert.New(t)
+
+	start := time.Now()
+	budget := NewBudget(Must(FromString("99999999999.0")))
+	budget.accrual.Last = start
+
+	budget.refill(Must(FromString("99999999999.0")), time.Nanosecond, start)
+	test.NoError(budget.spend(Must(FromString("1.0")), start))
+
+	test.Equal(
+		"99999999999.00000000",
+		budget.availableAt(start.Add(time.Hour)).String(),
+	)
+}
+
+func TestBudget_Refill_KeepsRemainderOnRateChange(t *testing.T) {
+	test := assert.New(t)
+
+	start := time.Now()
+	budget := NewBudget(Must(FromString("1.0")))
+	budget.accrual.Last = start
+
+	// 0.00000001 per 3 seconds, 2/3 of it is accrued before and 1/3 after
+	// every change of rate.
+	budget.refill(1, 3*time.Second, start)
+	test.NoError(budget.spend(Must(FromString("1.0")), start))
+
+	now := start.Add(2 * time.Second)
+	budget.refill(1, 3*time.Second, now)
+	test.Equal(Decimal(0), budget.availableAt(now))
+
+	now = now.Add(time.Second)
+	test.Equal(Decimal(1), budget.availableAt(now))
+
+	// Same fraction of 0.00000001 at rate of 0.00000001 per 6 seconds.
+	now = now.Add(2 * time.Second)
+	budget.refill(1, 6*time.Second, now)
+
+	now = now.Add(2 * time.Second)
+	test.Equal(Decimal(2), budget.availableAt(now))
+
+	budget.refill(0, 0, now)
+	budget.refill(1, 6*time.Second, now)
+
+	now = now.Add(5 * time.Second)
+	test.Equal(Decimal(2), budget.availableAt(now))
+}
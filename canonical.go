@@ -0,0 +1,200 @@
+package decimal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// CanonicalJSON returns JSON encoding of given value canonicalized according
+// to RFC 8785 (JSON Canonicalization Scheme): object keys are sorted by
+// their UTF-16 code units, whitespace is removed and strings and numbers are
+// serialized in the single form defined by the scheme. Output is suitable for
+// signing payloads.
+//
+// Decimal values are marshaled as JSON strings, so they are kept exactly as
+// returned by String() and don't go through float64 number serialization.
+func CanonicalJSON(value interface{}) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := writeCanonical(&buffer, document); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writeCanonical(buffer *bytes.Buffer, value interface{}) error {
+	switch value := value.(type) {
+	case nil:
+		buffer.WriteString("null")
+
+	case bool:
+		buffer.WriteString(strconv.FormatBool(value))
+
+	case string:
+		writeCanonicalString(buffer, value)
+
+	case json.Number:
+		number, err := value.Float64()
+		if err != nil || math.IsInf(number, 0) || math.IsNaN(number) {
+			return fmt.Errorf(
+				"canonical JSON can't represent number: %s", value,
+			)
+		}
+
+		buffer.WriteString(formatCanonicalNumber(number))
+
+	case []interface{}:
+		buffer.WriteByte('[')
+		for i, item := range value {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+
+			if err := writeCanonical(buffer, item); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			return lessUTF16(keys[i], keys[j])
+		})
+
+		buffer.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+
+			writeCanonicalString(buffer, key)
+			buffer.WriteByte(':')
+
+			if err := writeCanonical(buffer, value[key]); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+
+	default:
+		return fmt.Errorf("canonical JSON can't represent %T", value)
+	}
+
+	return nil
+}
+
+func writeCanonicalString(buffer *bytes.Buffer, value string) {
+	buffer.WriteByte('"')
+
+	for _, symbol := range value {
+		switch symbol {
+		case '"':
+			buffer.WriteString(`\"`)
+		case '\\':
+			buffer.WriteString(`\\`)
+		case '\b':
+			buffer.WriteString(`\b`)
+		case '\f':
+			buffer.WriteString(`\f`)
+		case '\n':
+			buffer.WriteString(`\n`)
+		case '\r':
+			buffer.WriteString(`\r`)
+		case '\t':
+			buffer.WriteString(`\t`)
+		default:
+			if symbol < 0x20 {
+				fmt.Fprintf(buffer, `\u%04x`, symbol)
+			} else {
+				buffer.WriteRune(symbol)
+			}
+		}
+	}
+
+	buffer.WriteByte('"')
+}
+
+// formatCanonicalNumber serializes number as Number.prototype.toString() of
+// ECMAScript does, as required by RFC 8785.
+func formatCanonicalNumber(number float64) string {
+	if number == 0 {
+		return "0"
+	}
+
+	sign := ""
+	if number < 0 {
+		sign = "-"
+		number = -number
+	}
+
+	// Shortest representation which round-trips, e.g. "1.2345e+02".
+	scientific := strconv.FormatFloat(number, 'e', -1, 64)
+	mark := strings.IndexByte(scientific, 'e')
+
+	exponent, _ := strconv.Atoi(scientific[mark+1:])
+	digits := strings.Replace(scientific[:mark], ".", "", 1)
+
+	var (
+		k = len(digits)
+		n = exponent + 1
+	)
+
+	switch {
+	case k <= n && n <= 21:
+		return sign + digits + strings.Repeat("0", n-k)
+
+	case 0 < n && n <= 21:
+		return sign + digits[:n] + "." + digits[n:]
+
+	case -6 < n && n <= 0:
+		return sign + "0." + strings.Repeat("0", -n) + digits
+	}
+
+	mantissa := digits[:1]
+	if k > 1 {
+		mantissa += "." + digits[1:]
+	}
+
+	if n-1 > 0 {
+		return sign + mantissa + "e+" + strconv.Itoa(n-1)
+	}
+
+	return sign + mantissa + "e-" + strconv.Itoa(1-n)
+}
+
+func lessUTF16(a, b string) bool {
+	x := utf16.Encode([]rune(a))
+	y := utf16.Encode([]rune(b))
+
+	for i := 0; i < len(x) && i < len(y); i++ {
+		if x[i] != y[i] {
+			return x[i] < y[i]
+		}
+	}
+
+	return len(x) < len(y)
+}
@@ -0,0 +1,82 @@
+package decimal
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalJSON_SortsKeysAndKeepsDecimals(t *testing.T) {
+	test := assert.New(t)
+
+	type approval struct {
+		To     string  `json:"to"`
+		Amount Decimal `json:"amount"`
+		Nonce  int     `json:"nonce"`
+	}
+
+	actual, err := CanonicalJSON(approval{
+		To:     "<addr>",
+		Amount: Must(FromString("1.5")),
+		Nonce:  10,
+	})
+	test.NoError(err)
+	test.Equal(`{"amount":"1.50000000","nonce":10,"to":"<addr>"}`, string(actual))
+}
+
+func TestCanonicalJSON_FollowsRFC8785Example(t *testing.T) {
+	test := assert.New(t)
+
+	input := `{
+		"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+		"string": "€$\u000F\u000aA'B\u0022\u005c\\\u0022\/",
+		"literals": [null, true, false]
+	}`
+
+	var document interface{}
+	test.NoError(json.Unmarshal([]byte(input), &document))
+
+	actual, err := CanonicalJSON(document)
+	test.NoError(err)
+	test.Equal(
+		`{"literals":[null,true,false],`+
+			`"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],`+
+			`"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+		string(actual),
+	)
+}
+
+func TestCanonicalJSON_SortsKeysByUTF16(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := CanonicalJSON(map[string]int{
+		"\U0001F600": 1,
+		"\uFB33":     2,
+		"a":          3,
+	})
+	test.NoError(err)
+	test.Equal("{\"a\":3,\"\U0001F600\":1,\"\uFB33\":2}", string(actual))
+}
+
+func TestFormatCanonicalNumber_MatchesECMAScript(t *testing.T) {
+	test := assert.New(t)
+
+	for number, expected := range map[float64]string{
+		0:                      "0",
+		math.Copysign(0, -1):   "0",
+		1:                      "1",
+		-1.5:                   "-1.5",
+		1e21:                   "1e+21",
+		1e20:                   "100000000000000000000",
+		0.000001:               "0.000001",
+		0.0000001:              "1e-7",
+		123456789012345680000:  "123456789012345680000",
+		9007199254740992:       "9007199254740992",
+		5e-324:                 "5e-324",
+		1.7976931348623157e308: "1.7976931348623157e+308",
+	} {
+		test.Equal(expected, formatCanonicalNumber(number))
+	}
+}
@@ -0,0 +1,49 @@
+package decimal
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"iter"
+	"math/bits"
+)
+
+// ChecksumXOF returns order-independent checksum of given multiset of
+// values, so ledgers can be compared between replicas without sorting.
+//
+// Every value is hashed with SHA-256 over its String() representation and
+// digests are summed as 256-bit big-endian integers modulo 2^256. Addition
+// is commutative, so order of values doesn't matter, while duplicates change
+// result. Checksums of disjoint parts can be merged with CombineChecksums().
+func ChecksumXOF(values iter.Seq[Decimal]) [32]byte {
+	var sum [32]byte
+
+	for value := range values {
+		digest := sha256.Sum256([]byte(value.String()))
+		sum = CombineChecksums(sum, digest)
+	}
+
+	return sum
+}
+
+// CombineChecksums returns checksum of union of multisets with given
+// checksums.
+func CombineChecksums(a, b [32]byte) [32]byte {
+	var (
+		sum   [32]byte
+		carry uint64
+	)
+
+	for i := len(sum) - 8; i >= 0; i -= 8 {
+		var limb uint64
+
+		limb, carry = bits.Add64(
+			binary.BigEndian.Uint64(a[i:]),
+			binary.BigEndian.Uint64(b[i:]),
+			carry,
+		)
+
+		binary.BigEndian.PutUint64(sum[i:], limb)
+	}
+
+	return sum
+}
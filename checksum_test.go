@@ -0,0 +1,60 @@
+package decimal
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecksumXOF_IsOrderIndependent(t *testing.T) {
+	test := assert.New(t)
+
+	a := []Decimal{
+		Must(FromString("1.0")),
+		Must(FromString("2.5")),
+		Must(FromString("0.00000001")),
+	}
+	b := []Decimal{a[2], a[0], a[1]}
+
+	test.Equal(ChecksumXOF(slices.Values(a)), ChecksumXOF(slices.Values(b)))
+}
+
+func TestChecksumXOF_CountsDuplicates(t *testing.T) {
+	test := assert.New(t)
+
+	value := Must(FromString("1.0"))
+
+	test.NotEqual(
+		ChecksumXOF(slices.Values([]Decimal{value})),
+		ChecksumXOF(slices.Values([]Decimal{value, value, value})),
+	)
+	test.NotEqual(
+		[32]byte{},
+		ChecksumXOF(slices.Values([]Decimal{value, value})),
+	)
+}
+
+func TestChecksumXOF_ReturnsZeroForEmptySet(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal([32]byte{}, ChecksumXOF(slices.Values([]Decimal(nil))))
+}
+
+func TestCombineChecksums_MergesParts(t *testing.T) {
+	test := assert.New(t)
+
+	values := []Decimal{
+		Must(FromString("1.0")),
+		Must(FromString("2.5")),
+		Must(FromString("3.25")),
+	}
+
+	test.Equal(
+		ChecksumXOF(slices.Values(values)),
+		CombineChecksums(
+			ChecksumXOF(slices.Values(values[:1])),
+			ChecksumXOF(slices.Values(values[1:])),
+		),
+	)
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const decimalPath = "github.com/openware/decimal"
+
+// fractionalUnits and maxUnits match decimal.MaxFractional and decimal.Max.
+const (
+	fractionalUnits = uint64(1e8)
+	maxUnits        = uint64(1e19)
+)
+
+// Analyzer reports:
+//
+// * conversions of untyped integer constants to decimal.Decimal, e.g.
+// decimal.Decimal(5), which hold 5 units of 0.00000001 instead of 5;
+//
+// * float multiplications passed to decimal.FromFloat* functions, which
+// loose precision before value is converted;
+//
+// * decimal.Lit() calls with arguments which are not constants, can't be
+// stored in decimal.Decimal or are integer literals without "_" before 8
+// fractional digits, e.g. decimal.Lit(1234500000) instead of
+// decimal.Lit(12_34500000);
+//
+// * octal, hexadecimal and binary literals passed to decimal.Lit() or
+// decimal.Decimal(), e.g. decimal.Lit(0_50000000), which is octal and holds
+// 0.10485760 instead of 0.5.
+//
+// Literals are checked both in call itself and in declaration of constant
+// passed to it.
+//
+// Generated files and decimal package itself are not checked.
+var Analyzer = &analysis.Analyzer{
+	Name: "decimalcheck",
+	Doc:  "report unsafe conversions to decimal.Decimal",
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Path() == decimalPath {
+		return nil, nil
+	}
+
+	for _, file := range pass.Files {
+		if ast.IsGenerated(file) {
+			continue
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return true
+			}
+
+			if isDecimal(pass.TypesInfo.Types[call.Fun]) {
+				checkConversion(pass, call)
+				return true
+			}
+
+			function := calledFunction(pass, call)
+			switch {
+			case function == nil:
+			case function.Name() == "Lit":
+				checkLiteral(pass, call)
+			case strings.HasPrefix(function.Name(), "FromFloat"):
+				checkFloat(pass, call, function)
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// checkConversion reports conversion of untyped integer constant to
+// decimal.Decimal. Zero is allowed, since it's the same in any units.
+func checkConversion(pass *analysis.Pass, call *ast.CallExpr) {
+	argument := pass.TypesInfo.Types[call.Args[0]]
+	if argument.Value == nil || argument.Value.Kind() != constant.Int {
+		return
+	}
+
+	basic, ok := argument.Type.Underlying().(*types.Basic)
+	untyped := ok && basic.Info()&types.IsUntyped != 0
+	if !untyped && !isDecimalType(argument.Type) {
+		return
+	}
+
+	units, exact := constant.Uint64Val(argument.Value)
+	if !exact || units == 0 {
+		return
+	}
+
+	if checkBase(pass, call, "decimal.Decimal") {
+		return
+	}
+
+	pass.Reportf(
+		call.Pos(),
+		"decimal.Decimal(%s) holds %s units of 0.00000001, "+
+			"not %s; use decimal.Lit() or decimal.FromString() instead",
+		argument.Value.ExactString(),
+		argument.Value.ExactString(),
+		argument.Value.ExactString(),
+	)
+}
+
+// checkLiteral reports decimal.Lit() call with argument which is not valid
+// constant or is integer literal with unreadable fractional digits.
+func checkLiteral(pass *analysis.Pass, call *ast.CallExpr) {
+	argument := pass.TypesInfo.Types[call.Args[0]]
+	if argument.Value == nil {
+		pass.Reportf(
+			call.Pos(),
+			"decimal.Lit() argument should be constant; "+
+				"use decimal.Decimal() for computed units",
+		)
+		return
+	}
+
+	units, exact := constant.Uint64Val(argument.Value)
+	if !exact || units >= maxUnits {
+		pass.Reportf(
+			call.Pos(),
+			"decimal.Lit(%s) can't be stored in decimal.Decimal",
+			argument.Value.ExactString(),
+		)
+		return
+	}
+
+	if checkBase(pass, call, "decimal.Lit") {
+		return
+	}
+
+	literal := literalOf(pass, call.Args[0])
+	if literal == nil || units < fractionalUnits {
+		return
+	}
+
+	digits := literal.Value
+	if strings.Trim(digits, "0123456789_") != "" {
+		return
+	}
+
+	separator := len(digits) - 9
+	if digits[separator] != '_' ||
+		strings.Contains(digits[separator+1:], "_") {
+		formatted := strings.ReplaceAll(digits, "_", "")
+		formatted = formatted[:len(formatted)-8] + "_" +
+			formatted[len(formatted)-8:]
+
+		pass.Reportf(
+			call.Args[0].Pos(),
+			"decimal.Lit(%s) should separate 8 fractional digits with _: "+
+				"decimal.Lit(%s)",
+			digits,
+			formatted,
+		)
+	}
+}
+
+// checkBase reports call of given function with integer literal which is
+// not decimal, e.g. 0_50000000, which is octal because of leading zero.
+func checkBase(pass *analysis.Pass, call *ast.CallExpr, function string) bool {
+	literal := literalOf(pass, call.Args[0])
+	if literal == nil {
+		return false
+	}
+
+	value := strings.ToLower(literal.Value)
+
+	var base string
+	switch {
+	case strings.HasPrefix(value, "0x"):
+		base = "hexadecimal"
+	case strings.HasPrefix(value, "0b"):
+		base = "binary"
+	case strings.HasPrefix(value, "0o"), len(value) > 1 && value[0] == '0':
+		base = "octal"
+	default:
+		return false
+	}
+
+	pass.Reportf(
+		call.Args[0].Pos(),
+		"%s(%s) is %s literal holding %s units of 0.00000001; "+
+			"write units as decimal literal without leading 0",
+		function,
+		literal.Value,
+		base,
+		pass.TypesInfo.Types[call.Args[0]].Value.ExactString(),
+	)
+
+	return true
+}
+
+// literalOf returns integer literal which is given expression itself or
+// initializes constant of checked package given expression refers to, or nil
+// otherwise.
+func literalOf(pass *analysis.Pass, expression ast.Expr) *ast.BasicLit {
+	switch expression := ast.Unparen(expression).(type) {
+	case *ast.BasicLit:
+		if expression.Kind == token.INT {
+			return expression
+		}
+	case *ast.Ident:
+		object, ok := pass.TypesInfo.Uses[expression].(*types.Const)
+		if !ok || object.Pkg() != pass.Pkg {
+			return nil
+		}
+
+		for _, file := range pass.Files {
+			for _, declaration := range file.Decls {
+				general, ok := declaration.(*ast.GenDecl)
+				if !ok || general.Tok != token.CONST {
+					continue
+				}
+
+				for _, spec := range general.Specs {
+					value := spec.(*ast.ValueSpec)
+					for i, name := range value.Names {
+						if pass.TypesInfo.Defs[name] == object && i < len(value.Values) {
+							return literalOf(pass, value.Values[i])
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkFloat reports float multiplication passed to decimal.FromFloat*.
+func checkFloat(pass *analysis.Pass, call *ast.CallExpr, function *types.Func) {
+	binary, ok := ast.Unparen(call.Args[0]).(*ast.BinaryExpr)
+	if !ok || binary.Op != token.MUL {
+		return
+	}
+
+	basic, ok := pass.TypesInfo.TypeOf(binary).Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsFloat == 0 {
+		return
+	}
+
+	pass.Reportf(
+		binary.Pos(),
+		"float multiplication passed to decimal.%s looses precision "+
+			"before conversion; convert operands and use "+
+			"Decimal.Multiply() instead",
+		function.Name(),
+	)
+}
+
+func calledFunction(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	var ident *ast.Ident
+
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	default:
+		return nil
+	}
+
+	function, ok := pass.TypesInfo.Uses[ident].(*types.Func)
+	if !ok || function.Pkg() == nil || function.Pkg().Path() != decimalPath {
+		return nil
+	}
+
+	return function
+}
+
+func isDecimal(value types.TypeAndValue) bool {
+	return value.IsType() && isDecimalType(value.Type)
+}
+
+func isDecimalType(kind types.Type) bool {
+	named, ok := kind.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	object := named.Obj()
+
+	return object.Pkg() != nil &&
+		object.Pkg().Path() == decimalPath &&
+		object.Name() == "Decimal"
+}
@@ -0,0 +1,14 @@
+// Command decimalcheck reports common precision bugs in code using
+// github.com/openware/decimal package. See Analyzer for list of checks.
+//
+// Usage:
+//	decimalcheck ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(Analyzer)
+}
@@ -0,0 +1,56 @@
+package a
+
+import (
+	"github.com/openware/decimal"
+)
+
+const units uint64 = 5
+
+const whole = 5
+
+func conversions(value uint64) []decimal.Decimal {
+	return []decimal.Decimal{
+		decimal.Decimal(5),     // want `decimal.Decimal\(5\) holds 5 units of 0.00000001`
+		decimal.Decimal(whole), // want `decimal.Decimal\(5\) holds 5 units`
+		decimal.Decimal(2 * 3), // want `decimal.Decimal\(6\) holds 6 units`
+		decimal.Decimal(0),
+		decimal.Decimal(0x10), // want `decimal.Decimal\(0x10\) is hexadecimal literal holding 16 units`
+		decimal.Decimal(units),
+		decimal.Decimal(value),
+	}
+}
+
+func floats(price, amount float64) []decimal.Decimal {
+	return []decimal.Decimal{
+		decimal.FromFloat64(price * amount),   // want `float multiplication passed to decimal.FromFloat64`
+		decimal.FromFloat64((price * amount)), // want `float multiplication passed to decimal.FromFloat64`
+		decimal.FromFloat64(price + amount),
+		decimal.FromFloat64(price),
+	}
+}
+
+const fee = 12_34500000
+
+const half = 0_50000000
+
+const unreadable = 1234500000
+
+func literals(value uint64) []decimal.Decimal {
+	return []decimal.Decimal{
+		decimal.Lit(12_34500000),
+		decimal.Lit(fee),
+		decimal.Lit(5),
+		decimal.Lit(50000000),
+		decimal.Lit(0),
+		decimal.Lit(0x10),                  // want `decimal.Lit\(0x10\) is hexadecimal literal holding 16 units`
+		decimal.Lit(0b1),                   // want `decimal.Lit\(0b1\) is binary literal`
+		decimal.Lit(0o17),                  // want `decimal.Lit\(0o17\) is octal literal`
+		decimal.Lit(0_50000000),            // want `decimal.Lit\(0_50000000\) is octal literal holding 10485760 units of 0.00000001; write units as decimal literal without leading 0`
+		decimal.Lit(half),                  // want `decimal.Lit\(0_50000000\) is octal literal holding 10485760 units`
+		decimal.Lit(unreadable),            // want `decimal.Lit\(1234500000\) should separate 8 fractional digits with _`
+		decimal.Lit(1234500000),            // want `decimal.Lit\(1234500000\) should separate 8 fractional digits with _: decimal.Lit\(12_34500000\)`
+		decimal.Lit(1_234_500_000),         // want `should separate 8 fractional digits with _: decimal.Lit\(12_34500000\)`
+		decimal.Lit(value),                 // want `decimal.Lit\(\) argument should be constant`
+		decimal.Lit(100000000000_00000000), // want `decimal.Lit\(10000000000000000000\) can.t be stored`
+	}
+}
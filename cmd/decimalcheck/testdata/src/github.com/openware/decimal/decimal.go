@@ -0,0 +1,15 @@
+package decimal
+
+type Decimal uint64
+
+func FromString(value string) (Decimal, error) {
+	return 0, nil
+}
+
+func FromFloat64(value float64) Decimal {
+	return Decimal(value)
+}
+
+func Lit(units uint64) Decimal {
+	return Decimal(units)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"strings"
+
+	"github.com/openware/decimal"
+)
+
+// generate returns formatted Go source of package with constants declared
+// in given input. Source is name of input used in generated comments.
+func generate(input io.Reader, pkg, source string) ([]byte, error) {
+	var (
+		scanner = bufio.NewScanner(input)
+		buffer  bytes.Buffer
+		seen    = map[string]int{}
+		line    int
+	)
+
+	fmt.Fprintf(&buffer, "// Code generated by decimalgen from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&buffer, "package %s\n\n", pkg)
+	fmt.Fprintf(&buffer, "import \"github.com/openware/decimal\"\n\n")
+	fmt.Fprintf(&buffer, "const (\n")
+
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		equals := strings.IndexByte(text, '=')
+		if equals < 0 {
+			return nil, fmt.Errorf(
+				"%s:%d: expected \"Name = value\": %q", source, line, text,
+			)
+		}
+
+		name := strings.TrimSpace(text[:equals])
+		literal := strings.TrimSpace(text[equals+1:])
+
+		if !token.IsIdentifier(name) {
+			return nil, fmt.Errorf(
+				"%s:%d: invalid constant name: %q", source, line, name,
+			)
+		}
+
+		if previous, ok := seen[name]; ok {
+			return nil, fmt.Errorf(
+				"%s:%d: constant %s is already declared on line %d",
+				source, line, name, previous,
+			)
+		}
+
+		seen[name] = line
+
+		value, err := decimal.FromString(literal)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %s", source, line, err)
+		}
+
+		fmt.Fprintf(&buffer, "\t// %s is %s.\n", name, value.String())
+		fmt.Fprintf(&buffer, "\t%s = decimal.Decimal(%d)\n", name, value.Uint64())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(&buffer, ")\n")
+
+	return format.Source(buffer.Bytes())
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate_DeclaresConstants(t *testing.T) {
+	test := assert.New(t)
+
+	code, err := generate(strings.NewReader(`
+		# minimal order amount
+		MinAmount = 0.0001
+		MaxPrice=1000000.0
+	`), "config", "limits.conf")
+	test.NoError(err)
+	test.Equal(`// Code generated by decimalgen from limits.conf; DO NOT EDIT.
+
+package config
+
+import "github.com/openware/decimal"
+
+const (
+	// MinAmount is 0.00010000.
+	MinAmount = decimal.Decimal(10000)
+	// MaxPrice is 1000000.00000000.
+	MaxPrice = decimal.Decimal(100000000000000)
+)
+`, string(code))
+}
+
+func TestGenerate_ReturnsErrorOnInvalidInput(t *testing.T) {
+	test := assert.New(t)
+
+	for input, message := range map[string]string{
+		"MinAmount 0.1":             `limits.conf:1: expected "Name = value"`,
+		"1Amount = 0.1":             "limits.conf:1: invalid constant name",
+		"MinAmount = 0.000000001":   "limits.conf:1: decimal type can't hold",
+		"A = 1.0\n\nA = 2.0":        "limits.conf:3: constant A is already declared on line 1",
+		"MaxPrice = 100000000000.0": "can't hold integer part",
+		"MinAmount = one.zero":      "can't be parsed",
+	} {
+		_, err := generate(strings.NewReader(input), "config", "limits.conf")
+		test.Error(err, input)
+		test.Contains(err.Error(), message, input)
+	}
+}
@@ -0,0 +1,63 @@
+// Command decimalgen turns list of decimal literals into Go file with
+// validated decimal.Decimal constants, so values don't have to be parsed
+// with decimal.Must(decimal.FromString(...)) during initialization.
+//
+// Input file contains one constant per line, blank lines and lines starting
+// with "#" are ignored:
+//	# minimal order amount
+//	MinAmount = 0.0001
+//	MaxPrice  = 1000000.0
+//
+// Usage with go:generate:
+//	//go:generate decimalgen -package config -input limits.conf -output limits_decimal.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	var (
+		input  = flag.String("input", "", "file with decimal literals")
+		output = flag.String("output", "", "generated Go file (default stdout)")
+		pkg    = flag.String("package", os.Getenv("GOPACKAGE"), "package name")
+	)
+
+	flag.Parse()
+
+	if *input == "" || *pkg == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	file, err := os.Open(*input)
+	if err != nil {
+		fatal(err)
+	}
+
+	defer file.Close()
+
+	code, err := generate(file, *pkg, filepath.Base(*input))
+	if err != nil {
+		fatal(err)
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(code)
+	} else {
+		err = ioutil.WriteFile(*output, code, 0644)
+	}
+
+	if err != nil {
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "decimalgen:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,117 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Column binary layout, all integers are big-endian:
+//
+//	offset  size      content
+//	0       4         magic "DCOL"
+//	4       4         version, 1
+//	8       8         number of values n
+//	16      8 × n     values as uint64 units of 0.00000001
+//
+// Big-endian values compare as bytes in the same order as numbers.
+const (
+	columnMagic      = "DCOL"
+	columnVersion    = 1
+	columnHeaderSize = 16
+)
+
+// Column is read-only view of Decimal values stored in column binary layout,
+// e.g. file mapped into memory. Values are read directly from underlying
+// bytes, so column doesn't need to be deserialized or loaded as a whole.
+//
+// Example:
+//	data, _ := syscall.Mmap(fd, 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
+//	column, err := decimal.NewColumn(data)
+//	index, ok := column.Search(price)
+type Column struct {
+	data []byte
+}
+
+// NewColumn returns Column reading values from given bytes. Function will
+// return error if header is invalid or size of data doesn't match number of
+// values. Data must not be modified while column is used.
+func NewColumn(data []byte) (Column, error) {
+	if len(data) < columnHeaderSize || string(data[:4]) != columnMagic {
+		return Column{}, fmt.Errorf("decimal column has invalid header")
+	}
+
+	if version := binary.BigEndian.Uint32(data[4:]); version != columnVersion {
+		return Column{}, fmt.Errorf(
+			"decimal column version %d is not supported", version,
+		)
+	}
+
+	count := binary.BigEndian.Uint64(data[8:])
+	if count > uint64(len(data)-columnHeaderSize)/8 ||
+		uint64(len(data)-columnHeaderSize) != count*8 {
+		return Column{}, fmt.Errorf(
+			"decimal column of %d values doesn't match size of %d bytes",
+			count,
+			len(data),
+		)
+	}
+
+	return Column{data: data}, nil
+}
+
+// WriteColumn writes given values to writer in column binary layout.
+// Values should be sorted in ascending order if column will be searched.
+func WriteColumn(writer io.Writer, values []Decimal) error {
+	buffer := make([]byte, columnHeaderSize, columnHeaderSize+8*len(values))
+	copy(buffer, columnMagic)
+	binary.BigEndian.PutUint32(buffer[4:], columnVersion)
+	binary.BigEndian.PutUint64(buffer[8:], uint64(len(values)))
+
+	for _, value := range values {
+		buffer = binary.BigEndian.AppendUint64(buffer, value.Uint64())
+	}
+
+	_, err := writer.Write(buffer)
+
+	return err
+}
+
+// Len returns number of values in column.
+func (column Column) Len() int {
+	return (len(column.data) - columnHeaderSize) / 8
+}
+
+// At returns value with given index. It panics if index is out of range.
+func (column Column) At(index int) Decimal {
+	if index < 0 || index >= column.Len() {
+		panic(fmt.Sprintf(
+			"decimal column index out of range: %d of %d", index, column.Len(),
+		))
+	}
+
+	offset := columnHeaderSize + 8*index
+
+	return Decimal(binary.BigEndian.Uint64(column.data[offset:]))
+}
+
+// Search searches for target in column sorted in ascending order like
+// SearchDecimals() does.
+func (column Column) Search(target Decimal) (int, bool) {
+	index := sort.Search(column.Len(), func(i int) bool {
+		return column.At(i) >= target
+	})
+
+	return index, index < column.Len() && column.At(index) == target
+}
+
+// SearchFloor searches column sorted in ascending order like SearchFloor()
+// does.
+func (column Column) SearchFloor(target Decimal) (int, bool) {
+	index := sort.Search(column.Len(), func(i int) bool {
+		return column.At(i) > target
+	})
+
+	return index - 1, index > 0
+}
@@ -0,0 +1,93 @@
+package decimal
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColumn_ReadsWrittenValues(t *testing.T) {
+	test := assert.New(t)
+
+	values := []Decimal{
+		0,
+		Must(FromString("1.5")),
+		Must(FromString("2.5")),
+		Must(FromString("99999999999.99999999")),
+	}
+
+	var buffer bytes.Buffer
+	test.NoError(WriteColumn(&buffer, values))
+	test.Equal(16+8*len(values), buffer.Len())
+	test.Equal("DCOL\x00\x00\x00\x01", buffer.String()[:8])
+
+	column, err := NewColumn(buffer.Bytes())
+	test.NoError(err)
+	test.Equal(len(values), column.Len())
+
+	for i, value := range values {
+		test.Equal(value, column.At(i))
+	}
+
+	test.Panics(func() {
+		column.At(len(values))
+	})
+}
+
+func TestColumn_Search_FindsValues(t *testing.T) {
+	test := assert.New(t)
+
+	values := []Decimal{
+		Must(FromString("1.0")),
+		Must(FromString("2.0")),
+		Must(FromString("3.0")),
+	}
+
+	var buffer bytes.Buffer
+	test.NoError(WriteColumn(&buffer, values))
+
+	column, err := NewColumn(buffer.Bytes())
+	test.NoError(err)
+
+	for _, target := range []string{"0.5", "1.0", "2.5", "3.0", "4.0"} {
+		value := Must(FromString(target))
+
+		index, ok := column.Search(value)
+		expectedIndex, expectedOK := SearchDecimals(values, value)
+		test.Equal(expectedIndex, index, target)
+		test.Equal(expectedOK, ok, target)
+
+		index, ok = column.SearchFloor(value)
+		expectedIndex, expectedOK = SearchFloor(values, value)
+		test.Equal(expectedIndex, index, target)
+		test.Equal(expectedOK, ok, target)
+	}
+}
+
+func TestNewColumn_ReturnsErrorOnInvalidData(t *testing.T) {
+	test := assert.New(t)
+
+	var buffer bytes.Buffer
+	test.NoError(WriteColumn(&buffer, []Decimal{1, 2}))
+	data := buffer.Bytes()
+
+	_, err := NewColumn(data[:8])
+	test.Error(err)
+	test.Contains(err.Error(), "invalid header")
+
+	_, err = NewColumn(data[:len(data)-1])
+	test.Error(err)
+	test.Contains(err.Error(), "doesn't match size")
+
+	invalid := append([]byte(nil), data...)
+	invalid[7] = 2
+	_, err = NewColumn(invalid)
+	test.Error(err)
+	test.Contains(err.Error(), "version 2")
+
+	invalid = append([]byte(nil), data...)
+	invalid[8] = 0xff
+	_, err = NewColumn(invalid)
+	test.Error(err)
+}
@@ -0,0 +1,157 @@
+
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Kind identifies number type of this package for Capability().
+type Kind int
+
+const (
+	// KindDecimal is Decimal type.
+	KindDecimal Kind = iota
+
+	// KindSigned is Signed type.
+	KindSigned
+
+	// KindFixed2 is Fixed[Places2] type.
+	KindFixed2
+
+	// KindFixed8 is Fixed[Places8] type.
+	KindFixed8
+
+	// KindFixed18 is Fixed[Places18] type.
+	KindFixed18
+
+	// KindBig is BigDecimal type.
+	KindBig
+)
+
+// Kinds returns all kinds known to Capability().
+func Kinds() []Kind {
+	return []Kind{KindDecimal, KindSigned, KindFixed2, KindFixed8, KindFixed18, KindBig}
+}
+
+// String returns name of type of kind.
+func (kind Kind) String() string {
+	switch kind {
+	case KindDecimal:
+		return "Decimal"
+	case KindSigned:
+		return "Signed"
+	case KindFixed2:
+		return "Fixed[Places2]"
+	case KindFixed8:
+		return "Fixed[Places8]"
+	case KindFixed18:
+		return "Fixed[Places18]"
+	case KindBig:
+		return "BigDecimal"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(kind))
+	}
+}
+
+// kindLimits describes values which type of kind can hold: places digits
+// after decimal point and values from -max to max, or from 0 to max if it's
+// not signed. BigDecimal is unlimited.
+type kindLimits struct {
+	unlimited bool
+	places    int
+	max       BigDecimal
+	signed    bool
+}
+
+// limits returns limits of kind and false if kind is unknown.
+func (kind Kind) limits() (kindLimits, bool) {
+	decimalMax := Decimal(Max - 1).Big()
+	fixedMax := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+	switch kind {
+	case KindDecimal:
+		return kindLimits{places: MaxPointsFractional, max: decimalMax}, true
+	case KindSigned:
+		return kindLimits{places: MaxPointsFractional, max: decimalMax, signed: true}, true
+	case KindFixed2:
+		places := Places2{}.Places()
+		return kindLimits{places: places, max: NewBigDecimal(fixedMax, -places)}, true
+	case KindFixed8:
+		places := Places8{}.Places()
+		return kindLimits{places: places, max: NewBigDecimal(fixedMax, -places)}, true
+	case KindFixed18:
+		places := Places18{}.Places()
+		return kindLimits{places: places, max: NewBigDecimal(fixedMax, -places)}, true
+	case KindBig:
+		return kindLimits{unlimited: true, signed: true}, true
+	default:
+		return kindLimits{}, false
+	}
+}
+
+// Conversion describes safety of converting values between two kinds.
+type Conversion struct {
+	// Rounds reports that some values have more places than target type
+	// holds, so conversion needs rounding mode.
+	Rounds bool
+
+	// Errors reports that some values are out of range of target type, so
+	// conversion may return error.
+	Errors bool
+}
+
+// Exact reports whether every value is converted exactly and without error.
+func (conversion Conversion) Exact() bool {
+	return !conversion.Rounds && !conversion.Errors
+}
+
+// String returns "exact" or list of "rounds" and "errors".
+func (conversion Conversion) String() string {
+	if conversion.Exact() {
+		return "exact"
+	}
+
+	var properties []string
+	if conversion.Rounds {
+		properties = append(properties, "rounds")
+	}
+	if conversion.Errors {
+		properties = append(properties, "errors")
+	}
+
+	return strings.Join(properties, ", ")
+}
+
+// Capability returns safety of converting any value of kind from to kind to,
+// e.g. to choose conversions of generic pipeline programmatically. It's
+// derived from places and range of both types, so it doesn't depend on
+// conversion function used. Conversion from or to unknown kind is reported
+// as both rounding and failing.
+//
+// Example:
+//	decimal.Capability(decimal.KindDecimal, decimal.KindFixed18).Exact() // will return true
+//	decimal.Capability(decimal.KindFixed18, decimal.KindDecimal).String() // will return "rounds, errors"
+func Capability(from, to Kind) Conversion {
+	source, ok := from.limits()
+	if !ok {
+		return Conversion{Rounds: true, Errors: true}
+	}
+
+	target, ok := to.limits()
+	if !ok {
+		return Conversion{Rounds: true, Errors: true}
+	}
+
+	if target.unlimited {
+		return Conversion{}
+	}
+
+	return Conversion{
+		Rounds: source.unlimited || source.places > target.places,
+		Errors: source.unlimited ||
+			(source.signed && !target.signed) ||
+			source.max.Cmp(target.max) > 0,
+	}
+}
@@ -0,0 +1,146 @@
+
+package decimal
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// holds reports whether type of given kind can hold given value exactly,
+// using conversions of type itself.
+func holds(kind Kind, value BigDecimal) bool {
+	switch kind {
+	case KindDecimal:
+		converted, err := value.Decimal(RoundDown)
+		return err == nil && converted.Big().Cmp(value) == 0
+
+	case KindSigned:
+		magnitude := value
+		if value.Sign() < 0 {
+			magnitude = value.Neg()
+		}
+
+		converted, err := magnitude.Decimal(RoundDown)
+		signed := NewSigned(converted, value.Sign() < 0)
+
+		return err == nil && signed.Big().Cmp(value) == 0
+
+	case KindFixed2:
+		_, err := ParseFixed[Places2](value.String())
+		return err == nil
+
+	case KindFixed8:
+		_, err := ParseFixed[Places8](value.String())
+		return err == nil
+
+	case KindFixed18:
+		_, err := ParseFixed[Places18](value.String())
+		return err == nil
+
+	default:
+		return true
+	}
+}
+
+// samples returns extreme values of given kind: the smallest positive
+// value, the greatest value and their negations for signed kinds.
+func samples(kind Kind) []BigDecimal {
+	limits, _ := kind.limits()
+	if limits.unlimited {
+		return []BigDecimal{
+			NewBigDecimal(big.NewInt(1), -30),
+			NewBigDecimal(big.NewInt(1), 40),
+			NewBigDecimal(big.NewInt(-1), 40),
+		}
+	}
+
+	values := []BigDecimal{NewBigDecimal(big.NewInt(1), -limits.places), limits.max}
+	if limits.signed {
+		values = append(values, values[0].Neg(), values[1].Neg())
+	}
+
+	return values
+}
+
+func TestKind_LimitsMatchTypes(t *testing.T) {
+	test := assert.New(t)
+
+	for _, kind := range Kinds() {
+		limits, ok := kind.limits()
+		test.True(ok, kind.String())
+
+		if limits.unlimited {
+			continue
+		}
+
+		unit := NewBigDecimal(big.NewInt(1), -limits.places)
+
+		test.True(holds(kind, limits.max), kind.String())
+		test.False(holds(kind, limits.max.Add(unit)), kind.String())
+		test.True(holds(kind, unit), kind.String())
+		test.False(holds(kind, NewBigDecimal(big.NewInt(1), -limits.places-1)), kind.String())
+		test.Equal(limits.signed, holds(kind, unit.Neg()), kind.String())
+		test.Equal(limits.signed, holds(kind, limits.max.Neg()), kind.String())
+	}
+}
+
+func TestCapability_MatchesConversionsOfExtremeValues(t *testing.T) {
+	test := assert.New(t)
+
+	for _, from := range Kinds() {
+		for _, to := range Kinds() {
+			capability := Capability(from, to)
+			target, _ := to.limits()
+
+			var rounds, errors bool
+			for _, value := range samples(from) {
+				rounded := value
+				if !target.unlimited {
+					rounded = value.Round(target.places, RoundDown)
+				}
+
+				if !holds(to, rounded) {
+					errors = true
+				} else if !holds(to, value) {
+					rounds = true
+				}
+			}
+
+			name := from.String() + " to " + to.String()
+			test.Equal(rounds, capability.Rounds, name)
+			test.Equal(errors, capability.Errors, name)
+		}
+	}
+}
+
+func TestCapability(t *testing.T) {
+	test := assert.New(t)
+
+	for _, example := range []struct {
+		from, to Kind
+		expected string
+	}{
+		{KindDecimal, KindDecimal, "exact"},
+		{KindDecimal, KindSigned, "exact"},
+		{KindSigned, KindDecimal, "errors"},
+		{KindDecimal, KindFixed2, "rounds"},
+		{KindDecimal, KindFixed18, "exact"},
+		{KindFixed2, KindDecimal, "errors"},
+		{KindFixed18, KindDecimal, "rounds, errors"},
+		{KindFixed8, KindFixed18, "errors"},
+		{KindBig, KindFixed18, "rounds, errors"},
+		{KindFixed18, KindBig, "exact"},
+		{Kind(42), KindBig, "rounds, errors"},
+	} {
+		test.Equal(
+			example.expected,
+			Capability(example.from, example.to).String(),
+			example.from.String()+" to "+example.to.String(),
+		)
+	}
+
+	test.True(Capability(KindSigned, KindBig).Exact())
+	test.Equal("Kind(42)", Kind(42).String())
+}
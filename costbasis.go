@@ -0,0 +1,149 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// CostBasis accumulates purchases and tracks weighted-average cost of held
+// quantity.
+//
+// Total cost is stored exactly; rounding happens only when cost is
+// realized by Sell(). Rounded realized cost is deducted from total cost, so
+// sum of all realized costs plus ExactCost() plus Residual() always equals
+// sum of purchases exactly.
+//
+// Zero value is empty CostBasis ready to use.
+type CostBasis struct {
+	quantity Decimal
+
+	// cost is total cost of held quantity in units of 1e-16.
+	cost big.Int
+
+	// residual is cost which is neither realized nor held, in units of
+	// 1e-16.
+	residual big.Int
+}
+
+// Buy adds purchase of given quantity at given price. Method will return
+// error if held quantity or its cost can't be stored in Decimal type.
+func (basis *CostBasis) Buy(quantity, price Decimal) error {
+	total, ok := add(basis.quantity, quantity)
+	if !ok {
+		return fmt.Errorf(
+			"decimal type can't hold quantity of cost basis: %s + %s",
+			basis.quantity.String(),
+			quantity.String(),
+		)
+	}
+
+	var cost big.Int
+	cost.SetUint64(quantity.Uint64())
+	cost.Mul(&cost, new(big.Int).SetUint64(price.Uint64()))
+	cost.Add(&cost, &basis.cost)
+
+	if _, ok := fromBig(RoundUp.divide(&cost, bigFractional)); !ok {
+		return fmt.Errorf(
+			"decimal type can't hold cost of cost basis: %s × %s",
+			quantity.String(),
+			price.String(),
+		)
+	}
+
+	basis.quantity = total
+	basis.cost.Set(&cost)
+
+	return nil
+}
+
+// Sell removes given quantity at average cost and returns its cost rounded
+// with given mode. Method will return error if quantity exceeds held one.
+//
+// Selling whole held quantity realizes all remaining cost rounded with given
+// mode, and part of 0.00000001 lost by rounding is kept in Residual().
+func (basis *CostBasis) Sell(
+	quantity Decimal,
+	mode RoundingMode,
+) (Decimal, error) {
+	if quantity > basis.quantity {
+		return 0, fmt.Errorf(
+			"cost basis can't sell %s, held quantity: %s",
+			quantity.String(),
+			basis.quantity.String(),
+		)
+	}
+
+	if quantity == 0 {
+		return 0, nil
+	}
+
+	var numerator big.Int
+	numerator.Mul(&basis.cost, new(big.Int).SetUint64(quantity.Uint64()))
+
+	var denominator big.Int
+	denominator.SetUint64(basis.quantity.Uint64())
+	denominator.Mul(&denominator, bigFractional)
+
+	realized, _ := fromBig(
+		mode.apply("cost-basis.sell", &numerator, &denominator, 1),
+	)
+
+	basis.quantity -= quantity
+
+	var deducted big.Int
+	deducted.SetUint64(realized.Uint64())
+	deducted.Mul(&deducted, bigFractional)
+
+	basis.cost.Sub(&basis.cost, &deducted)
+
+	// Cost left after selling whole quantity or exceeded by rounded up
+	// realization is less than 0.00000001 and is moved to residual.
+	if basis.quantity == 0 || basis.cost.Sign() < 0 {
+		basis.residual.Add(&basis.residual, &basis.cost)
+		basis.cost.SetInt64(0)
+	}
+
+	return realized, nil
+}
+
+// ExactCost returns exact total cost of held quantity.
+func (basis *CostBasis) ExactCost() BigDecimal {
+	return NewBigDecimal(&basis.cost, -2*MaxPointsFractional)
+}
+
+// Residual returns exact cost of purchases which is neither realized by
+// Sell() nor held, e.g. part of 0.00000001 left after rounded realization
+// of whole quantity. It's positive if realizations were rounded down and
+// negative if they were rounded up, and it changes by less than 0.00000001
+// per sale.
+func (basis *CostBasis) Residual() BigDecimal {
+	return NewBigDecimal(&basis.residual, -2*MaxPointsFractional)
+}
+
+// Quantity returns currently held quantity.
+func (basis *CostBasis) Quantity() Decimal {
+	return basis.quantity
+}
+
+// Cost returns total cost of held quantity rounded with given mode.
+func (basis *CostBasis) Cost(mode RoundingMode) Decimal {
+	cost, _ := fromBig(mode.apply("cost-basis.cost", &basis.cost, bigFractional, 1))
+	return cost
+}
+
+// AveragePrice returns weighted-average price of held quantity rounded with
+// given mode, or zero if nothing is held.
+func (basis *CostBasis) AveragePrice(mode RoundingMode) Decimal {
+	if basis.quantity == 0 {
+		return 0
+	}
+
+	price, _ := fromBig(mode.apply(
+		"cost-basis.average",
+		&basis.cost,
+		new(big.Int).SetUint64(basis.quantity.Uint64()),
+		1,
+	))
+
+	return price
+}
@@ -0,0 +1,106 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCostBasis_AveragePrice_IsWeighted(t *testing.T) {
+	test := assert.New(t)
+
+	var basis CostBasis
+
+	test.NoError(basis.Buy(Must(FromString("1.0")), Must(FromString("100.0"))))
+	test.NoError(basis.Buy(Must(FromString("2.0")), Must(FromString("101.0"))))
+
+	test.Equal("3.00000000", basis.Quantity().String())
+	test.Equal("302.00000000", basis.Cost(RoundDown).String())
+	test.Equal("100.66666666", basis.AveragePrice(RoundDown).String())
+	test.Equal("100.66666667", basis.AveragePrice(RoundHalfUp).String())
+}
+
+func TestCostBasis_Sell_ReconcilesWithPurchases(t *testing.T) {
+	test := assert.New(t)
+
+	var basis CostBasis
+
+	test.NoError(basis.Buy(Must(FromString("3.0")), Must(FromString("1.0"))))
+
+	first, err := basis.Sell(Must(FromString("1.0")), RoundHalfUp)
+	test.NoError(err)
+	test.Equal("1.00000000", first.String())
+
+	test.NoError(basis.Buy(Must(FromString("0.00000001")), Must(FromString("0.5"))))
+
+	second, err := basis.Sell(Must(FromString("1.0")), RoundUp)
+	test.NoError(err)
+
+	third, err := basis.Sell(basis.Quantity(), RoundUp)
+	test.NoError(err)
+
+	// 3 × 1 + 0.00000001 × 0.5 is rounded on realization only.
+	test.Equal("1.00000000", second.String())
+	test.Equal("1.00000001", third.String())
+	test.Equal(Decimal(0), basis.Quantity())
+	test.Equal(Decimal(0), basis.Cost(RoundUp))
+	test.Equal("-0.0000000050000000", basis.Residual().String())
+}
+
+func TestCostBasis_Residual_KeepsIdentityWithPurchases(t *testing.T) {
+	test := assert.New(t)
+
+	var basis CostBasis
+
+	half := Must(FromString("0.5"))
+	test.NoError(basis.Buy(Must(FromString("0.00000001")), half))
+
+	realized, err := basis.Sell(Must(FromString("0.00000001")), RoundDown)
+	test.NoError(err)
+	test.Equal(Decimal(0), realized)
+	test.Equal("0.0000000050000000", basis.Residual().String())
+
+	// Rounded up realization exceeds whole cost 0.000000003.
+	test.NoError(basis.Buy(Must(FromString("0.00000003")), Must(FromString("0.1"))))
+
+	realized, err = basis.Sell(Must(FromString("0.00000001")), RoundUp)
+	test.NoError(err)
+	test.Equal(Decimal(1), realized)
+	test.Equal(Decimal(2), basis.Quantity())
+	test.Equal("0.0000000000000000", basis.ExactCost().String())
+	test.Equal("-0.0000000020000000", basis.Residual().String())
+
+	realized, err = basis.Sell(basis.Quantity(), RoundUp)
+	test.NoError(err)
+	test.Equal(Decimal(0), realized)
+
+	// 0.000000005 + 0.000000003 purchased, 0.00000001 realized.
+	purchased := mustBig("0.000000008")
+	test.Equal(0, purchased.Cmp(mustBig("0.00000001").Add(basis.Residual())))
+}
+
+func TestCostBasis_Sell_ReturnsErrorOnExcessQuantity(t *testing.T) {
+	test := assert.New(t)
+
+	var basis CostBasis
+
+	test.NoError(basis.Buy(Must(FromString("1.0")), Must(FromString("1.0"))))
+
+	_, err := basis.Sell(Must(FromString("1.00000001")), RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "can't sell")
+}
+
+func TestCostBasis_Buy_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	var basis CostBasis
+
+	err := basis.Buy(
+		Must(FromString("10000000.0")),
+		Must(FromString("10000.0")),
+	)
+	test.Error(err)
+	test.Contains(err.Error(), "can't hold cost")
+	test.Equal(Decimal(0), basis.Quantity())
+}
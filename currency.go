@@ -0,0 +1,127 @@
+package decimal
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// currenciesCSV is table of currency minor units, see currencies.csv.
+//
+//go:embed currencies.csv
+var currenciesCSV string
+
+var (
+	currenciesOnce  sync.Once
+	currencyPlaces  map[string]int
+	currenciesCodes []string
+)
+
+// MinorUnits returns number of digits after decimal point of amounts of
+// currency with given code from built-in table, e.g. 0 for "JPY" and 2 for
+// "USD". Table contains ISO 4217 minor units of fiat currencies and
+// settlement precision of common crypto assets, which is at most 8. Codes
+// are case-insensitive. It returns false if currency is not in table.
+func MinorUnits(code string) (int, bool) {
+	currenciesOnce.Do(loadCurrencies)
+
+	places, ok := currencyPlaces[strings.ToUpper(code)]
+
+	return places, ok
+}
+
+// Currencies returns sorted codes of currencies in built-in table of
+// MinorUnits().
+func Currencies() []string {
+	currenciesOnce.Do(loadCurrencies)
+
+	return append([]string(nil), currenciesCodes...)
+}
+
+// FormatFor returns NumberFormatter which renders amounts of currency with
+// given code with its number of places, see MinorUnits(), rounding them
+// with DefaultPolicy(). Function will return error if currency is not in
+// table.
+//
+// Example:
+//	formatter, _ := decimal.FormatFor("JPY")
+//	formatter.Format(decimal.Must(decimal.FromString("1200.5"))) // will return "1200"
+func FormatFor(code string) (NumberFormatter, error) {
+	places, ok := MinorUnits(code)
+	if !ok {
+		return NumberFormatter{}, fmt.Errorf("unknown currency: %q", code)
+	}
+
+	return NumberFormatter{Places: places, Rounding: DefaultPolicy().Rounding}, nil
+}
+
+// RoundFor returns Scale which rounds amounts of currency with given code
+// to its number of places, see MinorUnits(), with DefaultPolicy(). Function
+// will return error if currency is not in table.
+//
+// Example:
+//	scale, _ := decimal.RoundFor("USD")
+//	scale.Apply(decimal.Must(decimal.FromString("12.345"))) // will return 12.34
+func RoundFor(code string) (Scale, error) {
+	places, ok := MinorUnits(code)
+	if !ok {
+		return Scale{}, fmt.Errorf("unknown currency: %q", code)
+	}
+
+	return WithMaxScale(places).Rounding(DefaultPolicy().Rounding), nil
+}
+
+func loadCurrencies() {
+	places, err := parseCurrencies(currenciesCSV)
+	if err != nil {
+		panic(err)
+	}
+
+	codes := make([]string, 0, len(places))
+	for code := range places {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	currencyPlaces, currenciesCodes = places, codes
+}
+
+// parseCurrencies parses table of currency codes and places with header.
+func parseCurrencies(table string) (map[string]int, error) {
+	reader := csv.NewReader(strings.NewReader(table))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("currency table can't be parsed: %s", err)
+	}
+
+	if len(records) == 0 || records[0][0] != "code" || records[0][1] != "places" {
+		return nil, fmt.Errorf("currency table should start with header: code,places")
+	}
+
+	currencies := make(map[string]int, len(records)-1)
+	for _, record := range records[1:] {
+		places, err := strconv.Atoi(record[1])
+		if err != nil || places < 0 || places > MaxPointsFractional {
+			return nil, fmt.Errorf(
+				"currency table has invalid places of %s: %q",
+				record[0],
+				record[1],
+			)
+		}
+
+		if _, ok := currencies[record[0]]; ok {
+			return nil, fmt.Errorf("currency table has duplicate %s", record[0])
+		}
+
+		currencies[record[0]] = places
+	}
+
+	return currencies, nil
+}
@@ -0,0 +1,100 @@
+package decimal
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinorUnits_ReturnsPlacesOfKnownCurrencies(t *testing.T) {
+	test := assert.New(t)
+
+	for code, expected := range map[string]int{
+		"JPY":  0,
+		"USD":  2,
+		"eur":  2,
+		"KWD":  3,
+		"CLF":  4,
+		"btc":  8,
+		"ETH":  8,
+		"USDT": 6,
+	} {
+		places, ok := MinorUnits(code)
+		test.True(ok, code)
+		test.Equal(expected, places, code)
+	}
+
+	_, ok := MinorUnits("XYZ")
+	test.False(ok)
+}
+
+func TestCurrencies_ReturnsSortedCodes(t *testing.T) {
+	test := assert.New(t)
+
+	codes := Currencies()
+	test.True(len(codes) > 150)
+	test.True(sort.StringsAreSorted(codes))
+	test.Contains(codes, "USD")
+
+	codes[0] = "changed"
+	test.NotEqual("changed", Currencies()[0])
+}
+
+func TestParseCurrencies_ValidatesTable(t *testing.T) {
+	test := assert.New(t)
+
+	for _, table := range []string{
+		"",
+		"currency,digits\nUSD,2\n",
+		"code,places\nUSD,9\n",
+		"code,places\nUSD,two\n",
+		"code,places\nUSD,2\nUSD,2\n",
+		"code,places\nUSD\n",
+	} {
+		_, err := parseCurrencies(table)
+		test.Error(err, table)
+	}
+}
+
+func TestFormatFor_UsesCurrencyPlaces(t *testing.T) {
+	test := assert.New(t)
+
+	for code, expected := range map[string]string{
+		"JPY": "1235",
+		"usd": "1234.57",
+		"KWD": "1234.568",
+		"BTC": "1234.56780000",
+	} {
+		formatter, err := FormatFor(code)
+		test.NoError(err, code)
+		test.Equal(expected, formatter.Format(Must(FromString("1234.5678"))), code)
+	}
+
+	_, err := FormatFor("XYZ")
+	test.Error(err)
+}
+
+func TestRoundFor_UsesDefaultPolicy(t *testing.T) {
+	test := assert.New(t)
+	defer resetDefaultPolicy()
+
+	scale, err := RoundFor("USD")
+	test.NoError(err)
+
+	rounded, err := scale.Apply(Must(FromString("12.345")))
+	test.NoError(err)
+	test.Equal("12.34000000", rounded.String())
+
+	test.NoError(SetDefaultPolicy(Policy{Rounding: RoundUp}))
+
+	scale, err = RoundFor("jpy")
+	test.NoError(err)
+
+	rounded, err = scale.Apply(Must(FromString("1200.1")))
+	test.NoError(err)
+	test.Equal("1201.00000000", rounded.String())
+
+	_, err = RoundFor("XYZ")
+	test.Error(err)
+}
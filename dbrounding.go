@@ -0,0 +1,148 @@
+package decimal
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// dbRoundingCheck is query evaluated by database and its result expected
+// from this package.
+type dbRoundingCheck struct {
+	query    string
+	expected Decimal
+}
+
+// dbRoundingChecks returns queries checking DECIMAL(19, 8) semantics of
+// database: range, ROUND() and rounding of products and quotients cast to
+// DECIMAL(19, 8), which SQL standard defines as rounding half away from
+// zero. Queries don't use placeholders, so they run unchanged on MySQL and
+// PostgreSQL.
+func dbRoundingChecks() []dbRoundingCheck {
+	cast := func(value string) string {
+		return fmt.Sprintf("CAST('%s' AS DECIMAL(19,8))", value)
+	}
+
+	var checks []dbRoundingCheck
+
+	for _, value := range []string{"0.0", "0.00000001", "99999999999.99999999"} {
+		checks = append(checks, dbRoundingCheck{
+			query:    "SELECT " + cast(value),
+			expected: Must(FromString(value)),
+		})
+	}
+
+	for _, example := range []struct {
+		value  string
+		places int
+	}{
+		{"1.125", 2},
+		{"1.135", 2},
+		{"1.145", 2},
+		{"2.5", 0},
+		{"3.5", 0},
+		{"0.00000015", 7},
+		{"0.00000025", 7},
+		{"99999999998.5", 0},
+	} {
+		value := Must(FromString(example.value))
+
+		checks = append(checks, dbRoundingCheck{
+			query: fmt.Sprintf(
+				"SELECT CAST(ROUND(%s, %d) AS DECIMAL(19,8))",
+				cast(example.value),
+				example.places,
+			),
+			expected: Must(value.Round(example.places, RoundHalfUp)),
+		})
+	}
+
+	for _, example := range []struct {
+		a, b string
+	}{
+		{"1.99999999", "1.01"},
+		{"0.00000001", "0.5"},
+		{"0.00000003", "0.5"},
+		{"12345.6789", "0.00012345"},
+		{"9999999.99999999", "0.99999999"},
+	} {
+		a, b := Must(FromString(example.a)), Must(FromString(example.b))
+
+		checks = append(checks, dbRoundingCheck{
+			query: fmt.Sprintf(
+				"SELECT CAST(%s * %s AS DECIMAL(19,8))",
+				cast(example.a),
+				cast(example.b),
+			),
+			expected: Must(a.MultiplyRound(b, RoundHalfUp)),
+		})
+	}
+
+	for _, example := range []struct {
+		a, b string
+	}{
+		{"1.0", "3.0"},
+		{"2.0", "3.0"},
+		{"0.00000001", "2.0"},
+		{"0.00000003", "2.0"},
+		{"100.0", "7.0"},
+	} {
+		a, b := Must(FromString(example.a)), Must(FromString(example.b))
+
+		checks = append(checks, dbRoundingCheck{
+			query: fmt.Sprintf(
+				"SELECT CAST(%s / %s AS DECIMAL(19,8))",
+				cast(example.a),
+				cast(example.b),
+			),
+			expected: Must(a.Div(b, RoundHalfUp)),
+		})
+	}
+
+	return checks
+}
+
+// MatchesDBRounding verifies that given database stores DECIMAL(19, 8)
+// values and rounds them the same way as this package does with
+// RoundHalfUp, e.g. before reconciling values computed in database with
+// values computed in Go. It returns nil if all checks match and error
+// listing mismatching queries otherwise.
+//
+// Queries only read literals, so any connection to MySQL or PostgreSQL can
+// be used.
+func MatchesDBRounding(db *sql.DB) error {
+	var mismatches []string
+
+	for _, check := range dbRoundingChecks() {
+		var text string
+		if err := db.QueryRow(check.query).Scan(&text); err != nil {
+			return fmt.Errorf(
+				"database rounding check %q failed: %s", check.query, err,
+			)
+		}
+
+		value := text
+		if !strings.Contains(value, ".") {
+			value += ".0"
+		}
+
+		actual, err := FromString(value)
+		if err != nil || actual != check.expected {
+			mismatches = append(mismatches, fmt.Sprintf(
+				"%s returned %s, expected %s",
+				check.query,
+				text,
+				check.expected.String(),
+			))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf(
+			"database rounding doesn't match decimal package: %s",
+			strings.Join(mismatches, "; "),
+		)
+	}
+
+	return nil
+}
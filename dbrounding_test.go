@@ -0,0 +1,136 @@
+package decimal
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// answersDriver is database/sql driver which answers queries from map.
+type answersDriver map[string]string
+
+func (answers answersDriver) Open(name string) (driver.Conn, error) {
+	return answersConn(answers), nil
+}
+
+func (answers answersDriver) Connect(context.Context) (driver.Conn, error) {
+	return answersConn(answers), nil
+}
+
+func (answers answersDriver) Driver() driver.Driver {
+	return answers
+}
+
+type answersConn map[string]string
+
+func (answers answersConn) Prepare(query string) (driver.Stmt, error) {
+	answer, ok := answers[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}
+
+	return answersStmt(answer), nil
+}
+
+func (answers answersConn) Close() error { return nil }
+
+func (answers answersConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions are not supported")
+}
+
+type answersStmt string
+
+func (answer answersStmt) Close() error  { return nil }
+func (answer answersStmt) NumInput() int { return 0 }
+
+func (answer answersStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, fmt.Errorf("exec is not supported")
+}
+
+func (answer answersStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &answersRows{answer: string(answer)}, nil
+}
+
+type answersRows struct {
+	answer string
+	done   bool
+}
+
+func (rows *answersRows) Columns() []string { return []string{"value"} }
+func (rows *answersRows) Close() error      { return nil }
+
+func (rows *answersRows) Next(dest []driver.Value) error {
+	if rows.done {
+		return io.EOF
+	}
+
+	rows.done = true
+	dest[0] = []byte(rows.answer)
+
+	return nil
+}
+
+func openAnswers(answers map[string]string) *sql.DB {
+	return sql.OpenDB(answersDriver(answers))
+}
+
+func TestDBRoundingChecks_RoundHalfAwayFromZero(t *testing.T) {
+	test := assert.New(t)
+
+	expected := map[string]string{
+		"SELECT CAST(ROUND(CAST('1.125' AS DECIMAL(19,8)), 2) AS DECIMAL(19,8))":                           "1.13000000",
+		"SELECT CAST(ROUND(CAST('2.5' AS DECIMAL(19,8)), 0) AS DECIMAL(19,8))":                             "3.00000000",
+		"SELECT CAST(CAST('0.00000001' AS DECIMAL(19,8)) * CAST('0.5' AS DECIMAL(19,8)) AS DECIMAL(19,8))": "0.00000001",
+		"SELECT CAST(CAST('2.0' AS DECIMAL(19,8)) / CAST('3.0' AS DECIMAL(19,8)) AS DECIMAL(19,8))":        "0.66666667",
+	}
+
+	checks := map[string]string{}
+	for _, check := range dbRoundingChecks() {
+		checks[check.query] = check.expected.String()
+	}
+
+	for query, value := range expected {
+		test.Equal(value, checks[query], query)
+	}
+}
+
+func TestMatchesDBRounding_AcceptsMatchingDatabase(t *testing.T) {
+	test := assert.New(t)
+
+	answers := map[string]string{}
+	for _, check := range dbRoundingChecks() {
+		answers[check.query] = check.expected.String()
+	}
+
+	// PostgreSQL doesn't pad values without fractional part.
+	answers["SELECT CAST('0.0' AS DECIMAL(19,8))"] = "0"
+
+	test.NoError(MatchesDBRounding(openAnswers(answers)))
+}
+
+func TestMatchesDBRounding_ReportsMismatches(t *testing.T) {
+	test := assert.New(t)
+
+	answers := map[string]string{}
+	for _, check := range dbRoundingChecks() {
+		answers[check.query] = check.expected.String()
+	}
+
+	query := "SELECT CAST(ROUND(CAST('2.5' AS DECIMAL(19,8)), 0) AS DECIMAL(19,8))"
+	answers[query] = "2.00000000"
+
+	err := MatchesDBRounding(openAnswers(answers))
+	test.Error(err)
+	test.Contains(err.Error(), query+" returned 2.00000000, expected 3.00000000")
+
+	delete(answers, query)
+
+	err = MatchesDBRounding(openAnswers(answers))
+	test.Error(err)
+	test.Contains(err.Error(), "check")
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"math/bits"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // NOTE: Max uint64 value is 18446744073709551615, which has string length
@@ -39,9 +41,17 @@ type Decimal uint64
 // if value can't be stored in Decimal type.
 // Used in SQL communication.
 func (decimal *Decimal) Scan(data interface{}) error {
+	if hook := latency(); hook != nil {
+		defer observeLatency(hook, "parse", time.Now())
+	}
+
+	return decimal.scan(data)
+}
+
+func (decimal *Decimal) scan(data interface{}) error {
 	switch data := data.(type) {
 	case []byte:
-		return decimal.Scan(string(data))
+		return decimal.scan(string(data))
 
 	case string:
 		period := strings.IndexByte(data, '.')
@@ -93,7 +103,7 @@ func (decimal *Decimal) Scan(data interface{}) error {
 			)
 		}
 
-		if fractional >= MaxFractional {
+		if fractional >= MaxFractional || tail-period > MaxPointsFractional {
 			return fmt.Errorf(
 				"decimal type can't hold fractional part of value: %q",
 				data,
@@ -123,6 +133,10 @@ func (decimal *Decimal) Scan(data interface{}) error {
 //
 // TODO: rework this method and remove need of big.Int (speed up)
 func (decimal Decimal) Multiply(multiplier Decimal) (Decimal, error) {
+	if hook := latency(); hook != nil {
+		defer observeLatency(hook, "mul", time.Now())
+	}
+
 	var factor big.Int
 
 	factor.SetUint64(MaxFractional)
@@ -164,11 +178,394 @@ func (decimal Decimal) Multiply(multiplier Decimal) (Decimal, error) {
 	), nil
 }
 
+// MultiplyRound returns result of multiplying current value with given
+// multiplier rounded to 8 places with given mode, e.g. price × fee rate.
+// Unlike Multiply() it returns error only if integer part of result can't
+// be stored in Decimal type.
+//
+// Example:
+//	decimal.Scan("1.99999999")
+//	decimal.MultiplyRound(rate, decimal.RoundHalfEven) // 2.01999999 for rate 1.01
+func (decimal Decimal) MultiplyRound(multiplier Decimal, mode RoundingMode) (Decimal, error) {
+	units := mode.apply("multiply", product(decimal, multiplier), bigFractional, 1)
+
+	result, ok := fromBig(units)
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold integer part of multiplication: "+
+				"%s × %s",
+			decimal.String(),
+			multiplier.String(),
+		)
+	}
+
+	return result, nil
+}
+
+// FMA returns current value multiplied by mul plus add, e.g. base + qty ×
+// tick, rounded once to 8 places with given mode. Method will return error
+// if result can't be stored in Decimal type.
+func (decimal Decimal) FMA(mul, add Decimal, mode RoundingMode) (Decimal, error) {
+	numerator := product(decimal, mul)
+	numerator.Add(numerator, new(big.Int).Mul(bigDecimal(add), bigFractional))
+
+	result, ok := fromBig(mode.apply("fma", numerator, bigFractional, 1))
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold result of multiply-add: %s × %s + %s",
+			decimal.String(),
+			mul.String(),
+			add.String(),
+		)
+	}
+
+	return result, nil
+}
+
+// Add returns sum of current value and given addend. Method will return
+// error if result exceeds maximum value of Decimal type.
+func (decimal Decimal) Add(addend Decimal) (Decimal, error) {
+	sum, ok := add(decimal, addend)
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold result of addition: %s + %s",
+			decimal.String(),
+			addend.String(),
+		)
+	}
+
+	return sum, nil
+}
+
+// Sub returns result of subtracting given subtrahend from current value.
+// Method will return error if subtrahend is greater than current value,
+// because Decimal type can't hold negative values.
+func (decimal Decimal) Sub(subtrahend Decimal) (Decimal, error) {
+	if subtrahend > decimal {
+		return 0, fmt.Errorf(
+			"decimal type can't hold negative result of subtraction: %s - %s",
+			decimal.String(),
+			subtrahend.String(),
+		)
+	}
+
+	return decimal - subtrahend, nil
+}
+
+// Div returns result of dividing current value by given divisor rounded
+// with given mode. Method will return error if divisor is zero or result
+// can't be stored in Decimal type.
+//
+// Example:
+//	decimal.Scan("1.0")
+//	decimal.Div(three, decimal.RoundHalfEven) // will return 0.33333333
+func (decimal Decimal) Div(divisor Decimal, mode RoundingMode) (Decimal, error) {
+	if divisor == 0 {
+		return 0, fmt.Errorf(
+			"decimal type can't be divided by zero: %s / 0", decimal.String(),
+		)
+	}
+
+	var numerator big.Int
+	numerator.Mul(bigDecimal(decimal), bigFractional)
+
+	quotient, ok := fromBig(mode.apply("div", &numerator, bigDecimal(divisor), 1))
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold result of division: %s / %s",
+			decimal.String(),
+			divisor.String(),
+		)
+	}
+
+	return quotient, nil
+}
+
+// MulDiv returns current value multiplied by mul and divided by div, e.g.
+// amount × rate / base, rounded once with given mode. Product is kept in
+// 128-bit intermediate, so result is exact up to final rounding and doesn't
+// overflow as long as it can be stored in Decimal type. Method will return
+// error if div is zero or result can't be stored in Decimal type.
+func (decimal Decimal) MulDiv(mul, div Decimal, mode RoundingMode) (Decimal, error) {
+	if div == 0 {
+		return 0, fmt.Errorf(
+			"decimal type can't be divided by zero: %s × %s / 0",
+			decimal.String(),
+			mul.String(),
+		)
+	}
+
+	// Units of 0.00000001 cancel out: a × b / c.
+	hi, lo := bits.Mul64(decimal.Uint64(), mul.Uint64())
+	if hi >= div.Uint64() {
+		return 0, decimal.overflowMulDiv(mul, div)
+	}
+
+	quotient, remainder := bits.Div64(hi, lo, div.Uint64())
+
+	if remainder != 0 {
+		half := compare(remainder, div.Uint64()-remainder)
+		if mode.increment(half, quotient%2 == 1, true) {
+			quotient++
+		}
+
+		reportInexact("muldiv", 1)
+	}
+
+	if quotient >= Max {
+		return 0, decimal.overflowMulDiv(mul, div)
+	}
+
+	return Decimal(quotient), nil
+}
+
+func (decimal Decimal) overflowMulDiv(mul, div Decimal) error {
+	return fmt.Errorf(
+		"decimal type can't hold result of multiplication and division: "+
+			"%s × %s / %s",
+		decimal.String(),
+		mul.String(),
+		div.String(),
+	)
+}
+
+// MulUint64 returns current value multiplied by given integer, e.g. amount
+// per contract multiplied by number of contracts. Method will return error
+// if result can't be stored in Decimal type.
+func (decimal Decimal) MulUint64(n uint64) (Decimal, error) {
+	hi, lo := bits.Mul64(decimal.Uint64(), n)
+	if hi != 0 || lo >= Max {
+		return 0, fmt.Errorf(
+			"decimal type can't hold result of multiplication: %s × %d",
+			decimal.String(),
+			n,
+		)
+	}
+
+	return Decimal(lo), nil
+}
+
+// DivUint64 returns current value divided by given integer rounded with
+// given mode, e.g. amount per contract. Method will return error if n is
+// zero.
+func (decimal Decimal) DivUint64(n uint64, mode RoundingMode) (Decimal, error) {
+	if n == 0 {
+		return 0, fmt.Errorf(
+			"decimal type can't be divided by zero: %s / 0", decimal.String(),
+		)
+	}
+
+	quotient, remainder := decimal.Uint64()/n, decimal.Uint64()%n
+
+	if remainder != 0 {
+		half := compare(remainder, n-remainder)
+		if mode.increment(half, quotient%2 == 1, true) {
+			quotient++
+		}
+
+		reportInexact("div", 1)
+	}
+
+	return Decimal(quotient), nil
+}
+
+// DivMod returns whole number of times given divisor fits into current
+// value and remainder left, e.g. number of whole lots in order quantity and
+// residual quantity. Division is exact: quotient × divisor + remainder is
+// equal to current value. Method will return error if divisor is zero or
+// quotient can't be stored in Decimal type.
+//
+// Example:
+//	decimal.Scan("10.5")
+//	decimal.DivMod(four) // will return 2, 2.5
+func (decimal Decimal) DivMod(divisor Decimal) (quotient, remainder Decimal, err error) {
+	if divisor == 0 {
+		return 0, 0, fmt.Errorf(
+			"decimal type can't be divided by zero: %s / 0", decimal.String(),
+		)
+	}
+
+	count := uint64(decimal) / uint64(divisor)
+	if count >= MaxInteger {
+		return 0, 0, fmt.Errorf(
+			"decimal type can't hold quotient of division: %s / %s",
+			decimal.String(),
+			divisor.String(),
+		)
+	}
+
+	return Decimal(count * MaxFractional), decimal % divisor, nil
+}
+
+// Mod returns remainder of current value with respect to given step, e.g.
+// how far price is above nearest lower tick. Unlike DivMod() it never
+// overflows. Method will return error if step is zero.
+//
+// Example:
+//	decimal.Scan("100.37")
+//	decimal.Mod(tick) // will return 0.02 for tick 0.05
+func (decimal Decimal) Mod(step Decimal) (Decimal, error) {
+	if step == 0 {
+		return 0, fmt.Errorf(
+			"decimal type can't be divided by zero: %s mod 0", decimal.String(),
+		)
+	}
+
+	return decimal % step, nil
+}
+
+// NextUnit returns smallest value greater than current one, i.e. value plus
+// 0.00000001, e.g. to place ask one satoshi below best ask. Method will
+// return error if current value is the largest one Decimal type can hold.
+//
+// Example:
+//	decimal.Scan("1.5")
+//	decimal.NextUnit() // will return 1.50000001
+func (decimal Decimal) NextUnit() (Decimal, error) {
+	if decimal.Uint64() >= Max-1 {
+		return 0, fmt.Errorf(
+			"decimal type can't hold value next to %s", decimal.String(),
+		)
+	}
+
+	return decimal + 1, nil
+}
+
+// PrevUnit returns largest value less than current one, i.e. value minus
+// 0.00000001. Method will return error if current value is zero.
+//
+// Example:
+//	decimal.Scan("1.5")
+//	decimal.PrevUnit() // will return 1.49999999
+func (decimal Decimal) PrevUnit() (Decimal, error) {
+	if decimal == 0 {
+		return 0, fmt.Errorf("decimal type can't hold negative value previous to 0")
+	}
+
+	return decimal - 1, nil
+}
+
+// AddChecked returns sum like Add() does, but reports overflow with false
+// instead of error, so it never allocates.
+func (decimal Decimal) AddChecked(addend Decimal) (Decimal, bool) {
+	return add(decimal, addend)
+}
+
+// SubChecked returns difference like Sub() does, but reports negative
+// result with false instead of error, so it never allocates.
+func (decimal Decimal) SubChecked(subtrahend Decimal) (Decimal, bool) {
+	if subtrahend > decimal {
+		return 0, false
+	}
+
+	return decimal - subtrahend, true
+}
+
+// MulChecked returns product like Multiply() does, but reports result which
+// can't be stored in Decimal type with false instead of error, so it never
+// allocates.
+func (decimal Decimal) MulChecked(multiplier Decimal) (Decimal, bool) {
+	hi, lo := bits.Mul64(decimal.Uint64(), multiplier.Uint64())
+	if hi >= MaxFractional {
+		return 0, false
+	}
+
+	product, remainder := bits.Div64(hi, lo, MaxFractional)
+	if remainder != 0 || product >= Max {
+		return 0, false
+	}
+
+	return Decimal(product), true
+}
+
+// AddSat returns sum of current value and given addend, or largest value
+// of Decimal type if sum can't be stored in it.
+func (decimal Decimal) AddSat(addend Decimal) Decimal {
+	sum, ok := add(decimal, addend)
+	if !ok {
+		return Decimal(Max - 1)
+	}
+
+	return sum
+}
+
+// SubSat returns result of subtracting given subtrahend from current value,
+// or zero if subtrahend is greater than current value.
+func (decimal Decimal) SubSat(subtrahend Decimal) Decimal {
+	if subtrahend > decimal {
+		return 0
+	}
+
+	return decimal - subtrahend
+}
+
+// MulSat returns product of current value and given multiplier truncated to
+// 8 places, or largest value of Decimal type if product can't be stored in
+// it.
+func (decimal Decimal) MulSat(multiplier Decimal) Decimal {
+	hi, lo := bits.Mul64(decimal.Uint64(), multiplier.Uint64())
+	if hi >= MaxFractional {
+		return Decimal(Max - 1)
+	}
+
+	product, _ := bits.Div64(hi, lo, MaxFractional)
+	if product >= Max {
+		return Decimal(Max - 1)
+	}
+
+	return Decimal(product)
+}
+
+// CanMultiply reports whether product of given values fits into Decimal
+// type. Check doesn't allocate and only considers magnitude of result, so
+// Multiply() may still return error if product needs more than 8 digits
+// after decimal point.
+func CanMultiply(a, b Decimal) bool {
+	hi, lo := bits.Mul64(a.Uint64(), b.Uint64())
+	if hi >= MaxFractional {
+		return false
+	}
+
+	product, _ := bits.Div64(hi, lo, MaxFractional)
+
+	return product < Max
+}
+
+// Headroom returns amount which can be added to current value before it
+// exceeds maximum value of Decimal type.
+//
+// Example:
+//	decimal.Scan("99999999990.0")
+//	decimal.Headroom() // will return 9.99999999
+func (decimal Decimal) Headroom() Decimal {
+	if uint64(decimal) >= Max {
+		return 0
+	}
+
+	return Decimal(Max - 1 - uint64(decimal))
+}
+
+// FitsSum reports whether sum of given values can be stored in Decimal type.
+func FitsSum(values ...Decimal) bool {
+	var total Decimal
+
+	for _, value := range values {
+		var ok bool
+
+		total, ok = add(total, value)
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Split returns integer and fractional components of number as uint64.
 //
 // Example:
 //	decimal.Scan("1234.5678")
-//	decimal.Split() // will return 1234, 5678
+//	decimal.Split() // will return 1234, 56780000
 func (decimal Decimal) Split() (uint64, uint64) {
 	var (
 		integer    = uint64(decimal) / MaxFractional
@@ -178,6 +575,32 @@ func (decimal Decimal) Split() (uint64, uint64) {
 	return integer, fractional
 }
 
+// FromSplit returns Decimal composed from integer and fractional components
+// returned by Split(), e.g. stored in separate columns. Fractional component
+// is number of units of 0.00000001, so it's always scale 8. Function will
+// return error if components can't be stored in Decimal type.
+//
+// Example:
+//	decimal.FromSplit(1234, 56780000) // will return 1234.5678
+func FromSplit(integer, fractional uint64) (Decimal, error) {
+	if integer >= MaxInteger {
+		return 0, fmt.Errorf(
+			"decimal type can't hold integer part of value: %d", integer,
+		)
+	}
+
+	if fractional >= MaxFractional {
+		return 0, fmt.Errorf(
+			"decimal type can't hold fractional part of value: %d, "+
+				"expected at most %d digits",
+			fractional,
+			MaxPointsFractional,
+		)
+	}
+
+	return Decimal(integer*MaxFractional + fractional), nil
+}
+
 // String returns string representation of Decimal type, always with leading
 // zeroes to pad to 8 places after decimal point.
 //
@@ -185,6 +608,10 @@ func (decimal Decimal) Split() (uint64, uint64) {
 //  decimal.Scan("0.0")
 //  decimal.String() // will return "0.00000000"
 func (decimal Decimal) String() string {
+	if hook := latency(); hook != nil {
+		defer observeLatency(hook, "format", time.Now())
+	}
+
 	value := uint64(decimal)
 
 	buffer := make([]byte, MaxPoints+1)
@@ -201,7 +628,7 @@ func (decimal Decimal) String() string {
 		j--
 	}
 
-	if j > MaxPointsInteger {
+	if j >= MaxPointsInteger {
 		for ; j > MaxPointsInteger; j-- {
 			buffer[j] = '0'
 		}
@@ -245,6 +672,41 @@ func FromString(value string) (Decimal, error) {
 	return number, err
 }
 
+// ParseLenient returns Decimal parsed from string input like FromString()
+// does, but also accepts forms with omitted zero before or after decimal
+// point, e.g. ".5" or "5.", common in hand-entered values.
+func ParseLenient(value string) (Decimal, error) {
+	switch {
+	case strings.HasPrefix(value, "."):
+		value = "0" + value
+	case strings.HasSuffix(value, "."):
+		value += "0"
+	}
+
+	return FromString(value)
+}
+
+// Lit returns Decimal holding given number of units of 0.00000001. It's
+// meant for constants, which then need neither parsing nor error handling:
+// write 8 fractional digits after "_" to keep value readable and let
+// decimalcheck verify it. Values below 1 are written without integer part,
+// since literal with leading "0_" is octal in Go, e.g. 0_50000000 holds
+// 0.10485760, not 0.5. Lit panics if value can't be stored in Decimal type.
+//
+// Example:
+//	const fee = 12_34500000 // 12.345
+//	var Fee = decimal.Lit(fee)
+//	var Half = decimal.Lit(50000000) // 0.5
+func Lit(units uint64) Decimal {
+	if units >= Max {
+		panic(fmt.Sprintf(
+			"decimal type can't hold %d units of 0.00000001", units,
+		))
+	}
+
+	return Decimal(units)
+}
+
 // Must is a helper that wraps a call to a function returning (Decimal, error)
 // and panics if the error is non-nil. It is intended for use in variable
 // initializations such as
@@ -256,3 +718,25 @@ func Must(number Decimal, err error) Decimal {
 
 	return number
 }
+
+// MustAdd returns sum like Add() does and panics on error. Like Must() it's
+// intended for initializations and tests, where operations can be chained:
+//	var total = price.MustAdd(fee).MustMul(quantity)
+func (decimal Decimal) MustAdd(addend Decimal) Decimal {
+	return Must(decimal.Add(addend))
+}
+
+// MustSub returns difference like Sub() does and panics on error.
+func (decimal Decimal) MustSub(subtrahend Decimal) Decimal {
+	return Must(decimal.Sub(subtrahend))
+}
+
+// MustMul returns product like Multiply() does and panics on error.
+func (decimal Decimal) MustMul(multiplier Decimal) Decimal {
+	return Must(decimal.Multiply(multiplier))
+}
+
+// MustDiv returns quotient like Div() does and panics on error.
+func (decimal Decimal) MustDiv(divisor Decimal, mode RoundingMode) Decimal {
+	return Must(decimal.Div(divisor, mode))
+}
@@ -65,6 +65,16 @@ func TestDecimal_Scan_ReturnsErrorOnTooPreciseNumber(t *testing.T) {
 	test.Contains(err.Error(), "can't hold fractional part")
 }
 
+func TestDecimal_Scan_ReturnsErrorOnTooPreciseNumberWithLeadingZeroes(t *testing.T) {
+	test := assert.New(t)
+
+	var actual Decimal
+
+	err := actual.Scan([]byte("1.000000015"))
+	test.Error(err)
+	test.Contains(err.Error(), "can't hold fractional part")
+}
+
 func TestDecimal_Scan_ReturnsErrorOnGarbage(t *testing.T) {
 	test := assert.New(t)
 
@@ -85,6 +95,15 @@ func TestDecimal_Scan_StripsTrailingZeroes(t *testing.T) {
 	test.Equal("1.99999999", actual.String())
 }
 
+func TestDecimal_String_PadsValuesBelowOne(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("0.10000000", Must(FromString("0.1")).String())
+	test.Equal("0.99999999", Must(FromString("0.99999999")).String())
+	test.Equal("0.01000000", Must(FromString("0.01")).String())
+	test.Equal("1.00000000", Must(FromString("1.0")).String())
+}
+
 func TestDecimal_Multiply_CanMultiply(t *testing.T) {
 	test := assert.New(t)
 
@@ -153,6 +172,583 @@ func TestDecimal_Multiply_ReturnsErrorWhenResultTooPrecise(t *testing.T) {
 	test.Contains(err.Error(), "fractional part of")
 }
 
+func TestDecimal_MultiplyRound_RoundsProduct(t *testing.T) {
+	test := assert.New(t)
+
+	multiplicand := Must(FromString("1.99999999"))
+	multiplier := Must(FromString("1.01"))
+
+	for mode, expected := range map[RoundingMode]string{
+		RoundDown:     "2.01999998",
+		RoundUp:       "2.01999999",
+		RoundHalfEven: "2.01999999",
+	} {
+		actual, err := multiplicand.MultiplyRound(multiplier, mode)
+		test.NoError(err)
+		test.Equal(expected, actual.String(), mode.String())
+	}
+
+	actual, err := Must(FromString("1.5")).MultiplyRound(Must(FromString("2.0")), RoundDown)
+	test.NoError(err)
+	test.Equal("3.00000000", actual.String())
+
+	actual, err = Must(FromString("0.00000001")).MultiplyRound(Must(FromString("0.5")), RoundHalfEven)
+	test.NoError(err)
+	test.Equal("0.00000000", actual.String())
+}
+
+func TestDecimal_MultiplyRound_ReturnsErrorWhenResultTooBig(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Must(FromString("99999999999.0")).MultiplyRound(Must(FromString("1.1")), RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "integer part of")
+}
+
+func TestDecimal_FMA_RoundsOnce(t *testing.T) {
+	test := assert.New(t)
+
+	quantity := Must(FromString("3.0"))
+	tick := Must(FromString("0.05"))
+	base := Must(FromString("100.0"))
+
+	actual, err := quantity.FMA(tick, base, RoundDown)
+	test.NoError(err)
+	test.Equal("100.15000000", actual.String())
+
+	// 0.00000001 × 0.5 + 0.00000001 = 0.000000015, rounds to even once.
+	half := Must(FromString("0.5"))
+
+	actual, err = Decimal(1).FMA(half, 1, RoundHalfEven)
+	test.NoError(err)
+	test.Equal(Decimal(2), actual)
+
+	actual, err = Decimal(1).FMA(half, 2, RoundHalfEven)
+	test.NoError(err)
+	test.Equal(Decimal(2), actual)
+
+	actual, err = Decimal(1).FMA(half, 2, RoundHalfUp)
+	test.NoError(err)
+	test.Equal(Decimal(3), actual)
+}
+
+func TestDecimal_FMA_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("99999999999.99999999"))
+
+	_, err := max.FMA(Must(FromString("1.0")), 1, RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "result of multiply-add")
+}
+
+func TestDecimal_Add_CanAdd(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Must(FromString("0.99999999")).Add(Must(FromString("0.00000001")))
+	test.NoError(err)
+	test.Equal("1.00000000", actual.String())
+
+	actual, err = Must(FromString("99999999999.99999998")).Add(Must(FromString("0.00000001")))
+	test.NoError(err)
+	test.Equal("99999999999.99999999", actual.String())
+}
+
+func TestDecimal_Add_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Must(FromString("99999999999.99999999")).Add(Must(FromString("0.00000001")))
+	test.Error(err)
+	test.Contains(err.Error(), "result of addition")
+
+	_, err = Decimal(Max - 1).Add(Decimal(Max - 1))
+	test.Error(err)
+}
+
+func TestDecimal_Sub_CanSubtract(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Must(FromString("1.0")).Sub(Must(FromString("0.00000001")))
+	test.NoError(err)
+	test.Equal("0.99999999", actual.String())
+
+	actual, err = Must(FromString("1.5")).Sub(Must(FromString("1.5")))
+	test.NoError(err)
+	test.Equal("0.00000000", actual.String())
+}
+
+func TestDecimal_Sub_ReturnsErrorOnUnderflow(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Must(FromString("1.0")).Sub(Must(FromString("1.00000001")))
+	test.Error(err)
+	test.Contains(err.Error(), "negative result of subtraction")
+}
+
+func TestDecimal_Div_RoundsWithGivenMode(t *testing.T) {
+	test := assert.New(t)
+
+	one := Must(FromString("1.0"))
+	three := Must(FromString("3.0"))
+	eight := Must(FromString("8.0"))
+
+	for _, example := range []struct {
+		dividend Decimal
+		divisor  Decimal
+		mode     RoundingMode
+		expected string
+	}{
+		{one, three, RoundDown, "0.33333333"},
+		{Must(FromString("2.0")), three, RoundDown, "0.66666666"},
+		{Must(FromString("2.0")), three, RoundHalfUp, "0.66666667"},
+		{Must(FromString("0.00000005")), Must(FromString("10.0")), RoundHalfUp, "0.00000001"},
+		{Must(FromString("0.00000005")), Must(FromString("10.0")), RoundHalfEven, "0.00000000"},
+		{Must(FromString("0.00000015")), Must(FromString("10.0")), RoundHalfEven, "0.00000002"},
+		{one, eight, RoundDown, "0.12500000"},
+		{one, Must(FromString("0.00000001")), RoundDown, "100000000.00000000"},
+	} {
+		actual, err := example.dividend.Div(example.divisor, example.mode)
+		test.NoError(err)
+		test.Equal(example.expected, actual.String(), "%s / %s (%s)",
+			example.dividend.String(), example.divisor.String(), example.mode)
+	}
+}
+
+func TestDecimal_Div_ReturnsErrorOnZeroOrOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Must(FromString("1.0")).Div(0, RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "divided by zero")
+
+	_, err = Must(FromString("1000.0")).Div(Must(FromString("0.00000001")), RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "result of division")
+}
+
+func TestDecimal_MulDiv_RoundsOnce(t *testing.T) {
+	test := assert.New(t)
+
+	amount := Must(FromString("99999999999.0"))
+	rate := Must(FromString("3.0"))
+	base := Must(FromString("7.0"))
+
+	actual, err := amount.MulDiv(rate, base, RoundDown)
+	test.NoError(err)
+	test.Equal("42857142856.71428571", actual.String())
+
+	actual, err = amount.MulDiv(rate, base, RoundHalfUp)
+	test.NoError(err)
+	test.Equal("42857142856.71428571", actual.String())
+
+	actual, err = amount.MulDiv(rate, base, RoundUp)
+	test.NoError(err)
+	test.Equal("42857142856.71428572", actual.String())
+
+	tie := Must(FromString("0.00000005"))
+	ten := Must(FromString("10.0"))
+	one := Must(FromString("1.0"))
+
+	actual, err = tie.MulDiv(one, ten, RoundHalfEven)
+	test.NoError(err)
+	test.Equal("0.00000000", actual.String())
+
+	actual, err = tie.MulDiv(one, ten, RoundHalfUp)
+	test.NoError(err)
+	test.Equal("0.00000001", actual.String())
+}
+
+func TestDecimal_MulDiv_ReportsInexactResult(t *testing.T) {
+	test := assert.New(t)
+
+	var lost []Decimal
+	OnInexact(func(op string, value Decimal) {
+		test.Equal("muldiv", op)
+		lost = append(lost, value)
+	})
+	defer OnInexact(nil)
+
+	one := Must(FromString("1.0"))
+
+	_, err := one.MulDiv(one, Must(FromString("3.0")), RoundHalfEven)
+	test.NoError(err)
+
+	_, err = one.MulDiv(one, Must(FromString("4.0")), RoundHalfEven)
+	test.NoError(err)
+
+	test.Equal([]Decimal{1}, lost)
+}
+
+func TestDecimal_MulDiv_ReturnsErrorOnZeroOrOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	amount := Must(FromString("99999999999.0"))
+
+	_, err := amount.MulDiv(amount, 0, RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "divided by zero")
+
+	_, err = amount.MulDiv(amount, Must(FromString("1.0")), RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "result of multiplication and division")
+
+	_, err = Must(FromString("99999999999.99999999")).MulDiv(
+		Must(FromString("1.0")), Must(FromString("0.99999999")), RoundUp,
+	)
+	test.Error(err)
+}
+
+func TestDecimal_MulUint64_CanMultiply(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Must(FromString("0.00012345")).MulUint64(1000)
+	test.NoError(err)
+	test.Equal("0.12345000", actual.String())
+
+	actual, err = Must(FromString("99999999999.99999999")).MulUint64(1)
+	test.NoError(err)
+	test.Equal("99999999999.99999999", actual.String())
+
+	actual, err = Must(FromString("99999999999.99999999")).MulUint64(0)
+	test.NoError(err)
+	test.Equal("0.00000000", actual.String())
+}
+
+func TestDecimal_MulUint64_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Must(FromString("50000000000.0")).MulUint64(2)
+	test.Error(err)
+	test.Contains(err.Error(), "result of multiplication")
+
+	_, err = Must(FromString("2.0")).MulUint64(1 << 63)
+	test.Error(err)
+}
+
+func TestDecimal_DivUint64_RoundsWithGivenMode(t *testing.T) {
+	test := assert.New(t)
+
+	for _, example := range []struct {
+		value    string
+		n        uint64
+		mode     RoundingMode
+		expected string
+	}{
+		{"1.0", 3, RoundDown, "0.33333333"},
+		{"2.0", 3, RoundHalfUp, "0.66666667"},
+		{"0.00000005", 10, RoundHalfUp, "0.00000001"},
+		{"0.00000005", 10, RoundHalfEven, "0.00000000"},
+		{"0.00000015", 10, RoundHalfEven, "0.00000002"},
+		{"0.00000001", 1 << 63, RoundUp, "0.00000001"},
+		{"10.0", 4, RoundDown, "2.50000000"},
+	} {
+		actual, err := Must(FromString(example.value)).DivUint64(example.n, example.mode)
+		test.NoError(err)
+		test.Equal(example.expected, actual.String(), "%s / %d (%s)",
+			example.value, example.n, example.mode)
+	}
+
+	_, err := Must(FromString("1.0")).DivUint64(0, RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "divided by zero")
+}
+
+func TestDecimal_DivMod_ReturnsWholeQuotientAndRemainder(t *testing.T) {
+	test := assert.New(t)
+
+	quotient, remainder, err := Must(FromString("10.5")).DivMod(Must(FromString("4.0")))
+	test.NoError(err)
+	test.Equal("2.00000000", quotient.String())
+	test.Equal("2.50000000", remainder.String())
+
+	quotient, remainder, err = Must(FromString("1.0")).DivMod(Must(FromString("0.3")))
+	test.NoError(err)
+	test.Equal("3.00000000", quotient.String())
+	test.Equal("0.10000000", remainder.String())
+
+	quotient, remainder, err = Must(FromString("0.1")).DivMod(Must(FromString("0.3")))
+	test.NoError(err)
+	test.Equal("0.00000000", quotient.String())
+	test.Equal("0.10000000", remainder.String())
+}
+
+func TestDecimal_DivMod_ReturnsErrorOnZeroOrOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	_, _, err := Must(FromString("1.0")).DivMod(0)
+	test.Error(err)
+	test.Contains(err.Error(), "divided by zero")
+
+	_, _, err = Must(FromString("1000.0")).DivMod(Must(FromString("0.00000001")))
+	test.Error(err)
+	test.Contains(err.Error(), "quotient of division")
+}
+
+func TestDecimal_Mod_ReturnsDistanceFromStep(t *testing.T) {
+	test := assert.New(t)
+
+	tick := Must(FromString("0.05"))
+
+	actual, err := Must(FromString("100.37")).Mod(tick)
+	test.NoError(err)
+	test.Equal("0.02000000", actual.String())
+
+	actual, err = Must(FromString("100.35")).Mod(tick)
+	test.NoError(err)
+	test.Equal("0.00000000", actual.String())
+
+	actual, err = Must(FromString("1000.0")).Mod(1)
+	test.NoError(err)
+	test.Equal("0.00000000", actual.String())
+
+	_, err = tick.Mod(0)
+	test.Error(err)
+	test.Contains(err.Error(), "divided by zero")
+}
+
+func TestDecimal_NextUnit_AddsSingleUnit(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Must(FromString("1.5")).NextUnit()
+	test.NoError(err)
+	test.Equal("1.50000001", actual.String())
+
+	actual, err = Decimal(Max - 2).NextUnit()
+	test.NoError(err)
+	test.Equal(Decimal(Max-1), actual)
+
+	_, err = Decimal(Max - 1).NextUnit()
+	test.Error(err)
+}
+
+func TestDecimal_PrevUnit_SubtractsSingleUnit(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Must(FromString("1.5")).PrevUnit()
+	test.NoError(err)
+	test.Equal("1.49999999", actual.String())
+
+	actual, err = Decimal(1).PrevUnit()
+	test.NoError(err)
+	test.Equal(Decimal(0), actual)
+
+	_, err = Decimal(0).PrevUnit()
+	test.Error(err)
+}
+
+func TestParseLenient_AcceptsOmittedZeroes(t *testing.T) {
+	test := assert.New(t)
+
+	for value, expected := range map[string]string{
+		".5":   "0.50000000",
+		"5.":   "5.00000000",
+		"5.25": "5.25000000",
+		".0":   "0.00000000",
+	} {
+		actual, err := ParseLenient(value)
+		test.NoError(err, value)
+		test.Equal(expected, actual.String(), value)
+	}
+
+	for _, value := range []string{".", "", "5", "..5", ".000000001"} {
+		_, err := ParseLenient(value)
+		test.Error(err, value)
+	}
+}
+
+func TestLit_HoldsUnits(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("12.34500000", Lit(12_34500000).String())
+	test.Equal("0.00000001", Lit(1).String())
+	test.Equal("99999999999.99999999", Lit(99999999999_99999999).String())
+}
+
+func TestLit_PanicsOnTooBigValue(t *testing.T) {
+	test := assert.New(t)
+
+	test.Panics(func() {
+		Lit(Max)
+	})
+}
+
+func TestFromSplit_ComposesComponentsOfSplit(t *testing.T) {
+	test := assert.New(t)
+
+	for _, value := range []string{"0.0", "1234.5678", "0.00000001", "99999999999.99999999"} {
+		expected := Must(FromString(value))
+
+		actual, err := FromSplit(expected.Split())
+		test.NoError(err, value)
+		test.Equal(expected, actual, value)
+	}
+}
+
+func TestFromSplit_ReturnsErrorOnInvalidComponents(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := FromSplit(MaxInteger, 0)
+	test.Error(err)
+	test.Contains(err.Error(), "integer part")
+
+	_, err = FromSplit(1, MaxFractional)
+	test.Error(err)
+	test.Contains(err.Error(), "fractional part")
+}
+
+func TestDecimal_Checked_ReportFailures(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("99999999999.99999999"))
+
+	sum, ok := Must(FromString("1.5")).AddChecked(Must(FromString("2.5")))
+	test.True(ok)
+	test.Equal("4.00000000", sum.String())
+
+	_, ok = max.AddChecked(1)
+	test.False(ok)
+
+	difference, ok := Must(FromString("2.5")).SubChecked(Must(FromString("1.5")))
+	test.True(ok)
+	test.Equal("1.00000000", difference.String())
+
+	_, ok = Decimal(1).SubChecked(2)
+	test.False(ok)
+
+	product, ok := Must(FromString("1.5")).MulChecked(Must(FromString("0.1")))
+	test.True(ok)
+	test.Equal("0.15000000", product.String())
+
+	_, ok = Must(FromString("99999999999.0")).MulChecked(Must(FromString("1.1")))
+	test.False(ok)
+
+	_, ok = Must(FromString("1.99999999")).MulChecked(Must(FromString("1.01")))
+	test.False(ok)
+
+	_, ok = max.MulChecked(max)
+	test.False(ok)
+}
+
+func TestDecimal_Checked_DoNotAllocate(t *testing.T) {
+	test := assert.New(t)
+
+	x := Must(FromString("99999999999.0"))
+	y := Must(FromString("1.1"))
+
+	test.Zero(testing.AllocsPerRun(100, func() {
+		x.AddChecked(x)
+		x.SubChecked(y)
+		x.MulChecked(y)
+	}))
+}
+
+func TestDecimal_Sat_ClampsResult(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("99999999999.99999999"))
+
+	test.Equal("4.00000000", Must(FromString("1.5")).AddSat(Must(FromString("2.5"))).String())
+	test.Equal(max, max.AddSat(1))
+	test.Equal(max, max.AddSat(max))
+
+	test.Equal("1.00000000", Must(FromString("2.5")).SubSat(Must(FromString("1.5"))).String())
+	test.Equal(Decimal(0), Decimal(1).SubSat(2))
+
+	test.Equal("0.15000000", Must(FromString("1.5")).MulSat(Must(FromString("0.1"))).String())
+	test.Equal("2.01999998", Must(FromString("1.99999999")).MulSat(Must(FromString("1.01"))).String())
+	test.Equal(max, Must(FromString("99999999999.0")).MulSat(Must(FromString("1.1"))))
+	test.Equal(max, max.MulSat(max))
+}
+
+func TestDecimal_Must_ChainsOperations(t *testing.T) {
+	test := assert.New(t)
+
+	price := Must(FromString("10.0"))
+	fee := Must(FromString("0.5"))
+
+	test.Equal(
+		"5.00000000",
+		price.MustAdd(fee).MustMul(Must(FromString("2.0"))).
+			MustSub(Must(FromString("1.0"))).
+			MustDiv(Must(FromString("4.0")), RoundDown).
+			String(),
+	)
+}
+
+func TestDecimal_Must_PanicsOnError(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("99999999999.99999999"))
+
+	test.Panics(func() { max.MustAdd(1) })
+	test.Panics(func() { Decimal(1).MustSub(2) })
+	test.Panics(func() { max.MustMul(max) })
+	test.Panics(func() { max.MustDiv(0, RoundDown) })
+}
+
+func TestCanMultiply_ReturnsTrueWhenResultFits(t *testing.T) {
+	test := assert.New(t)
+
+	test.True(CanMultiply(
+		Must(FromString("99999999999.0")),
+		Must(FromString("1.0")),
+	))
+	test.True(CanMultiply(
+		Must(FromString("1.99999999")),
+		Must(FromString("1.01")),
+	))
+	test.True(CanMultiply(0, Must(FromString("99999999999.99999999"))))
+}
+
+func TestCanMultiply_ReturnsFalseWhenResultTooBig(t *testing.T) {
+	test := assert.New(t)
+
+	test.False(CanMultiply(
+		Must(FromString("99999999999.0")),
+		Must(FromString("1.1")),
+	))
+	test.False(CanMultiply(
+		Must(FromString("99999999999.99999999")),
+		Must(FromString("99999999999.99999999")),
+	))
+	test.False(CanMultiply(
+		Must(FromString("10000000.0")),
+		Must(FromString("10000.0")),
+	))
+}
+
+func TestDecimal_Headroom_ReturnsDistanceToMax(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal(
+		"9.99999999",
+		Must(FromString("99999999990.0")).Headroom().String(),
+	)
+	test.Equal(
+		"99999999999.99999999",
+		Decimal(0).Headroom().String(),
+	)
+	test.Equal(
+		"0.00000000",
+		Must(FromString("99999999999.99999999")).Headroom().String(),
+	)
+}
+
+func TestFitsSum_ReportsOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	test.True(FitsSum())
+	test.True(FitsSum(
+		Must(FromString("99999999990.0")),
+		Must(FromString("9.99999999")),
+	))
+	test.False(FitsSum(
+		Must(FromString("99999999990.0")),
+		Must(FromString("9.99999999")),
+		Must(FromString("0.00000001")),
+	))
+}
+
 func BenchmarkDecimal_Scan(b *testing.B) {
 	var decimal Decimal
 
@@ -192,3 +788,24 @@ func BenchmarkDecimal_Multiplication(b *testing.B) {
 		x.Multiply(y)
 	}
 }
+
+func BenchmarkDecimal_MulUint64(b *testing.B) {
+	x := Must(FromString("1234.5678"))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x.MulUint64(1000)
+	}
+}
+
+func BenchmarkCanMultiply(b *testing.B) {
+	var x Decimal
+	var y Decimal
+
+	x.Scan([]byte("123.4567"))
+	y.Scan([]byte("123.4567"))
+
+	for i := 0; i < b.N; i++ {
+		CanMultiply(x, y)
+	}
+}
@@ -0,0 +1,96 @@
+package decimal
+
+import (
+	"sort"
+)
+
+// Decimals is slice of Decimal values with batch operations. It implements
+// sort.Interface in ascending order.
+type Decimals []Decimal
+
+// Len returns number of values.
+func (values Decimals) Len() int {
+	return len(values)
+}
+
+// Less reports whether value with index i is less than value with index j.
+func (values Decimals) Less(i, j int) bool {
+	return values[i] < values[j]
+}
+
+// Swap swaps values with indexes i and j.
+func (values Decimals) Swap(i, j int) {
+	values[i], values[j] = values[j], values[i]
+}
+
+// Sort sorts values in ascending order in place.
+func (values Decimals) Sort() {
+	sort.Sort(values)
+}
+
+// Sum returns sum of values, see SumSlice().
+func (values Decimals) Sum() (Decimal, error) {
+	return SumSlice(values)
+}
+
+// Min returns the least value and false if slice is empty.
+func (values Decimals) Min() (Decimal, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+
+	min := values[0]
+	for _, value := range values[1:] {
+		if value < min {
+			min = value
+		}
+	}
+
+	return min, true
+}
+
+// Max returns the greatest value and false if slice is empty.
+func (values Decimals) Max() (Decimal, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+
+	max := values[0]
+	for _, value := range values[1:] {
+		if value > max {
+			max = value
+		}
+	}
+
+	return max, true
+}
+
+// Contains reports whether given value is in slice. Use SearchDecimals()
+// for sorted slices.
+func (values Decimals) Contains(target Decimal) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Filter returns new slice of values for which keep returns true, in
+// original order.
+//
+// Example:
+//	large := amounts.Filter(func(amount decimal.Decimal) bool {
+//		return amount >= threshold
+//	})
+func (values Decimals) Filter(keep func(Decimal) bool) Decimals {
+	var filtered Decimals
+	for _, value := range values {
+		if keep(value) {
+			filtered = append(filtered, value)
+		}
+	}
+
+	return filtered
+}
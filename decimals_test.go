@@ -0,0 +1,75 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimals_Sort(t *testing.T) {
+	test := assert.New(t)
+
+	values := Decimals{3, 1, 2, 1}
+	values.Sort()
+
+	test.Equal(Decimals{1, 1, 2, 3}, values)
+}
+
+func TestDecimals_Sum(t *testing.T) {
+	test := assert.New(t)
+
+	sum, err := Decimals{1, 2, 3}.Sum()
+	test.NoError(err)
+	test.Equal(Decimal(6), sum)
+
+	sum, err = Decimals(nil).Sum()
+	test.NoError(err)
+	test.Equal(Decimal(0), sum)
+
+	_, err = Decimals{Decimal(Max - 1), 1}.Sum()
+	test.Error(err)
+}
+
+func TestDecimals_MinMax(t *testing.T) {
+	test := assert.New(t)
+
+	values := Decimals{3, 1, 4, 1, 5}
+
+	min, ok := values.Min()
+	test.True(ok)
+	test.Equal(Decimal(1), min)
+
+	max, ok := values.Max()
+	test.True(ok)
+	test.Equal(Decimal(5), max)
+
+	_, ok = Decimals{}.Min()
+	test.False(ok)
+
+	_, ok = Decimals{}.Max()
+	test.False(ok)
+}
+
+func TestDecimals_Contains(t *testing.T) {
+	test := assert.New(t)
+
+	values := Decimals{3, 1, 4}
+
+	test.True(values.Contains(4))
+	test.False(values.Contains(2))
+	test.False(Decimals(nil).Contains(0))
+}
+
+func TestDecimals_Filter(t *testing.T) {
+	test := assert.New(t)
+
+	values := Decimals{3, 1, 4, 1, 5}
+
+	filtered := values.Filter(func(value Decimal) bool {
+		return value > 2
+	})
+
+	test.Equal(Decimals{3, 4, 5}, filtered)
+	test.Equal(Decimals{3, 1, 4, 1, 5}, values)
+	test.Empty(values.Filter(func(Decimal) bool { return false }))
+}
@@ -0,0 +1,102 @@
+package decimal
+
+import (
+	"fmt"
+	"iter"
+)
+
+// BookSide is side of order book.
+type BookSide int
+
+const (
+	// BookBids are buy orders, best price is the highest.
+	BookBids BookSide = iota
+
+	// BookAsks are sell orders, best price is the lowest.
+	BookAsks
+)
+
+// String returns name of book side.
+func (side BookSide) String() string {
+	switch side {
+	case BookBids:
+		return "bids"
+	case BookAsks:
+		return "asks"
+	default:
+		return fmt.Sprintf("BookSide(%d)", int(side))
+	}
+}
+
+// DepthLevel is aggregated price level of order book with total quantity of
+// this and all better levels.
+type DepthLevel struct {
+	Level
+	Cumulative Decimal
+}
+
+// AggregateDepth groups given orders of one side of order book into price
+// levels which are multiples of given tick, e.g. 0.5, and returns them from
+// best price to worst with cumulative depth. Prices are grouped away from
+// spread, i.e. bids are rounded down and asks up to tick, so aggregated
+// level never shows better price than its orders. Levels with zero quantity
+// are omitted. Function will return error if tick is zero, side is unknown
+// or price or quantity can't be stored in Decimal type.
+//
+// Example:
+//	decimal.AggregateDepth(asks, decimal.Must(decimal.FromString("0.5")), decimal.BookAsks)
+func AggregateDepth(orders []Level, tick Decimal, side BookSide) ([]DepthLevel, error) {
+	levels, err := NewPriceLevels(tick)
+	if err != nil {
+		return nil, err
+	}
+
+	var iterate func() iter.Seq2[Decimal, Decimal]
+
+	switch side {
+	case BookBids:
+		iterate = levels.Descending
+	case BookAsks:
+		iterate = levels.Ascending
+	default:
+		return nil, fmt.Errorf("unknown book side: %s", side)
+	}
+
+	for _, order := range orders {
+		price := order.Price - order.Price%tick
+		if side == BookAsks && price != order.Price {
+			var ok bool
+			if price, ok = add(price, tick); !ok {
+				return nil, fmt.Errorf(
+					"decimal type can't hold price %s rounded up to tick %s",
+					order.Price.String(),
+					tick.String(),
+				)
+			}
+		}
+
+		if err := levels.Add(price, order.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	depth := make([]DepthLevel, 0, levels.Len())
+
+	var cumulative Decimal
+	for price, quantity := range iterate() {
+		var ok bool
+		if cumulative, ok = add(cumulative, quantity); !ok {
+			return nil, fmt.Errorf(
+				"decimal type can't hold cumulative depth at price %s",
+				price.String(),
+			)
+		}
+
+		depth = append(depth, DepthLevel{
+			Level:      Level{Price: price, Quantity: quantity},
+			Cumulative: cumulative,
+		})
+	}
+
+	return depth, nil
+}
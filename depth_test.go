@@ -0,0 +1,87 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func level(price, quantity string) Level {
+	return Level{Price: Must(FromString(price)), Quantity: Must(FromString(quantity))}
+}
+
+func depthLevel(price, quantity, cumulative string) DepthLevel {
+	return DepthLevel{
+		Level:      level(price, quantity),
+		Cumulative: Must(FromString(cumulative)),
+	}
+}
+
+func TestAggregateDepth_Bids(t *testing.T) {
+	test := assert.New(t)
+
+	depth, err := AggregateDepth([]Level{
+		level("100.3", "1.0"),
+		level("100.7", "2.0"),
+		level("100.5", "0.5"),
+		level("99.9", "4.0"),
+		level("100.0", "0.0"),
+	}, Must(FromString("0.5")), BookBids)
+	test.NoError(err)
+	test.Equal([]DepthLevel{
+		depthLevel("100.5", "2.5", "2.5"),
+		depthLevel("100.0", "1.0", "3.5"),
+		depthLevel("99.5", "4.0", "7.5"),
+	}, depth)
+}
+
+func TestAggregateDepth_Asks(t *testing.T) {
+	test := assert.New(t)
+
+	depth, err := AggregateDepth([]Level{
+		level("100.3", "1.0"),
+		level("100.7", "2.0"),
+		level("100.5", "0.5"),
+		level("99.9", "4.0"),
+	}, Must(FromString("0.5")), BookAsks)
+	test.NoError(err)
+	test.Equal([]DepthLevel{
+		depthLevel("100.0", "4.0", "4.0"),
+		depthLevel("100.5", "1.5", "5.5"),
+		depthLevel("101.0", "2.0", "7.5"),
+	}, depth)
+
+	depth, err = AggregateDepth(nil, Must(FromString("0.5")), BookAsks)
+	test.NoError(err)
+	test.Empty(depth)
+}
+
+func TestAggregateDepth_Errors(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := AggregateDepth(nil, 0, BookBids)
+	test.Error(err)
+
+	_, err = AggregateDepth(nil, 1, BookSide(42))
+	test.Error(err)
+
+	_, err = AggregateDepth([]Level{
+		{Price: Decimal(Max - 1), Quantity: 1},
+	}, Must(FromString("1.0")), BookAsks)
+	test.Error(err)
+
+	_, err = AggregateDepth([]Level{
+		{Price: 1, Quantity: Decimal(Max - 1)},
+		{Price: 2, Quantity: 1},
+	}, 1, BookBids)
+	test.Error(err)
+	test.Contains(err.Error(), "cumulative depth")
+}
+
+func TestBookSide_String(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("bids", BookBids.String())
+	test.Equal("asks", BookAsks.String())
+	test.Equal("BookSide(42)", BookSide(42).String())
+}
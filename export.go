@@ -0,0 +1,198 @@
+package decimal
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Cell is single value written by Export().
+type Cell struct {
+	// Text is exact text representation of value.
+	Text string
+
+	// Decimal reports that cell holds Decimal value, so writers of formats
+	// with typed cells (e.g. XLSX) can mark it as number.
+	Decimal bool
+}
+
+// RowWriter is implemented by table formats which Export() can write to.
+// Writers implementing Flush() error are flushed after last row.
+type RowWriter interface {
+	WriteRow(cells []Cell) error
+}
+
+// ColumnFormat controls how Decimal values of single column are written.
+type ColumnFormat struct {
+	// Places is number of digits after decimal point, from 0 to 8. Export()
+	// returns error instead of rounding if value needs more places.
+	Places int
+
+	// Trim removes trailing zeroes after Places digits are written, and
+	// decimal point if nothing is left after it.
+	Trim bool
+}
+
+// ExportOptions controls Export().
+type ExportOptions struct {
+	// Header writes row of column names before values.
+	Header bool
+
+	// Columns contains formats of Decimal columns by column name. Columns
+	// without format are written with 8 places.
+	Columns map[string]ColumnFormat
+}
+
+// Export writes given slice of structs to writer, one row per element and
+// one column per exported field. Column name is field name or name given in
+// `export:"name"` tag; fields tagged with `export:"-"` are skipped.
+//
+// Decimal fields are written exactly according to ExportOptions, other
+// fields are written using fmt.Sprint().
+func Export(writer RowWriter, rows interface{}, options ExportOptions) error {
+	value := reflect.ValueOf(rows)
+	if value.Kind() != reflect.Slice ||
+		value.Type().Elem().Kind() != reflect.Struct {
+		return fmt.Errorf(
+			"export expects slice of structs, but %T received", rows,
+		)
+	}
+
+	var (
+		kind    = value.Type().Elem()
+		indexes []int
+		names   []string
+	)
+
+	for i := 0; i < kind.NumField(); i++ {
+		field := kind.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Name
+		if tag := field.Tag.Get("export"); tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+
+		indexes = append(indexes, i)
+		names = append(names, name)
+	}
+
+	if options.Header {
+		header := make([]Cell, len(names))
+		for i, name := range names {
+			header[i] = Cell{Text: name}
+		}
+
+		if err := writer.WriteRow(header); err != nil {
+			return err
+		}
+	}
+
+	decimalType := reflect.TypeOf(Decimal(0))
+
+	for row := 0; row < value.Len(); row++ {
+		cells := make([]Cell, len(indexes))
+
+		for i, index := range indexes {
+			field := value.Index(row).Field(index)
+
+			if field.Type() != decimalType {
+				cells[i] = Cell{Text: fmt.Sprint(field.Interface())}
+				continue
+			}
+
+			format, ok := options.Columns[names[i]]
+			if !ok {
+				format = ColumnFormat{Places: MaxPointsFractional}
+			}
+
+			text, err := format.format(Decimal(field.Uint()))
+			if err != nil {
+				return fmt.Errorf(
+					"export can't write column %s of row %d: %s",
+					names[i],
+					row,
+					err,
+				)
+			}
+
+			cells[i] = Cell{Text: text, Decimal: true}
+		}
+
+		if err := writer.WriteRow(cells); err != nil {
+			return err
+		}
+	}
+
+	if flusher, ok := writer.(interface{ Flush() error }); ok {
+		return flusher.Flush()
+	}
+
+	return nil
+}
+
+// ExportCSV writes given slice of structs as CSV. See Export().
+func ExportCSV(writer io.Writer, rows interface{}, options ExportOptions) error {
+	return Export(NewCSVRowWriter(writer), rows, options)
+}
+
+// CSVRowWriter is RowWriter producing CSV.
+type CSVRowWriter struct {
+	writer *csv.Writer
+}
+
+// NewCSVRowWriter returns CSVRowWriter writing to given writer.
+func NewCSVRowWriter(writer io.Writer) *CSVRowWriter {
+	return &CSVRowWriter{writer: csv.NewWriter(writer)}
+}
+
+// WriteRow writes cells as single CSV record.
+func (writer *CSVRowWriter) WriteRow(cells []Cell) error {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		record[i] = cell.Text
+	}
+
+	return writer.writer.Write(record)
+}
+
+// Flush writes buffered records to underlying writer.
+func (writer *CSVRowWriter) Flush() error {
+	writer.writer.Flush()
+	return writer.writer.Error()
+}
+
+func (format ColumnFormat) format(decimal Decimal) (string, error) {
+	if format.Places < 0 || format.Places > MaxPointsFractional {
+		return "", fmt.Errorf(
+			"number of places should be from 0 to %d: %d",
+			MaxPointsFractional,
+			format.Places,
+		)
+	}
+
+	text := decimal.String()
+	period := strings.IndexByte(text, '.')
+
+	if strings.TrimRight(text[period+1+format.Places:], "0") != "" {
+		return "", fmt.Errorf(
+			"value can't be written with %d places: %s",
+			format.Places,
+			text,
+		)
+	}
+
+	text = text[:period+1+format.Places]
+
+	if format.Trim {
+		text = strings.TrimRight(text, "0")
+	}
+
+	return strings.TrimSuffix(text, "."), nil
+}
@@ -0,0 +1,91 @@
+package decimal
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTrade struct {
+	Market string
+	Price  Decimal `export:"price"`
+	Amount Decimal `export:"amount"`
+	Fee    Decimal
+	ID     int `export:"-"`
+	note   string
+}
+
+type testRowWriter struct {
+	rows [][]Cell
+}
+
+func (writer *testRowWriter) WriteRow(cells []Cell) error {
+	writer.rows = append(writer.rows, cells)
+	return nil
+}
+
+func TestExportCSV_WritesExactValues(t *testing.T) {
+	test := assert.New(t)
+
+	trades := []testTrade{
+		{
+			Market: "btcjpy",
+			Price:  Must(FromString("4000000.0")),
+			Amount: Must(FromString("0.12345678")),
+			Fee:    Must(FromString("0.5")),
+			note:   "hidden",
+		},
+	}
+
+	var buffer bytes.Buffer
+	err := ExportCSV(&buffer, trades, ExportOptions{
+		Header: true,
+		Columns: map[string]ColumnFormat{
+			"price": {Places: 0},
+			"Fee":   {Places: 4, Trim: true},
+		},
+	})
+	test.NoError(err)
+	test.Equal(
+		"Market,price,amount,Fee\n"+
+			"btcjpy,4000000,0.12345678,0.5\n",
+		buffer.String(),
+	)
+}
+
+func TestExport_MarksDecimalCells(t *testing.T) {
+	test := assert.New(t)
+
+	var writer testRowWriter
+	err := Export(&writer, []testTrade{{Market: "btcusd"}}, ExportOptions{})
+	test.NoError(err)
+	test.Equal([][]Cell{{
+		{Text: "btcusd"},
+		{Text: "0.00000000", Decimal: true},
+		{Text: "0.00000000", Decimal: true},
+		{Text: "0.00000000", Decimal: true},
+	}}, writer.rows)
+}
+
+func TestExport_ReturnsErrorInsteadOfRounding(t *testing.T) {
+	test := assert.New(t)
+
+	var writer testRowWriter
+	err := Export(
+		&writer,
+		[]testTrade{{Price: Must(FromString("1.5"))}},
+		ExportOptions{Columns: map[string]ColumnFormat{"price": {Places: 0}}},
+	)
+	test.Error(err)
+	test.Contains(err.Error(), "column price of row 0")
+}
+
+func TestExport_ReturnsErrorOnNonSlice(t *testing.T) {
+	test := assert.New(t)
+
+	var writer testRowWriter
+	err := Export(&writer, testTrade{}, ExportOptions{})
+	test.Error(err)
+	test.Contains(err.Error(), "slice of structs")
+}
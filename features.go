@@ -0,0 +1,96 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// FeatureSet describes capabilities of this build of the package, so
+// services can verify they agree on representation before exchanging
+// binary encoded decimals, e.g. during rolling upgrades.
+type FeatureSet struct {
+	// Backend is storage type of Decimal, e.g. "uint64".
+	Backend string `json:"backend"`
+
+	// Bits is width of Decimal storage in bits.
+	Bits int `json:"bits"`
+
+	// MaxPlaces is maximum number of digits after decimal point. Scales from
+	// 0 to MaxPlaces are supported.
+	MaxPlaces int `json:"max_places"`
+
+	// Max is the largest value which Decimal type can hold. It's a string,
+	// so values of wider backends can be described as well.
+	Max string `json:"max"`
+
+	// Signed reports whether Decimal type holds negative values. Signed type
+	// stores sign separately and doesn't affect it.
+	Signed bool `json:"signed"`
+
+	// ColumnVersion is version of format written by WriteColumn().
+	ColumnVersion int `json:"column_version"`
+
+	// Latency reports whether latency instrumentation is compiled in, see
+	// OnLatency().
+	Latency bool `json:"latency"`
+
+	// Rounding is rounding mode of DefaultPolicy().
+	Rounding string `json:"rounding"`
+}
+
+// Features returns FeatureSet of this build of the package. Rounding
+// reflects policy at the time of call.
+func Features() FeatureSet {
+	return FeatureSet{
+		Backend:       "uint64",
+		Bits:          64,
+		MaxPlaces:     MaxPointsFractional,
+		Max:           Decimal(Max - 1).String(),
+		Signed:        false,
+		ColumnVersion: columnVersion,
+		Latency:       latencyEnabled,
+		Rounding:      DefaultPolicy().Rounding.String(),
+	}
+}
+
+// Compatible returns error if binary encoded decimals produced by build
+// with other features can't be decoded by build with current features
+// without loss. Instrumentation and rounding policy don't affect
+// compatibility.
+func (features FeatureSet) Compatible(other FeatureSet) error {
+	switch {
+	case features.Backend != other.Backend || features.Bits != other.Bits:
+		return fmt.Errorf(
+			"decimal backend %s/%d is not compatible with %s/%d",
+			other.Backend,
+			other.Bits,
+			features.Backend,
+			features.Bits,
+		)
+
+	case features.MaxPlaces != other.MaxPlaces:
+		return fmt.Errorf(
+			"decimal with %d places is not compatible with %d places",
+			other.MaxPlaces,
+			features.MaxPlaces,
+		)
+
+	case other.Signed && !features.Signed:
+		return fmt.Errorf("signed decimals are not supported")
+
+	case other.ColumnVersion > features.ColumnVersion:
+		return fmt.Errorf(
+			"decimal column version %d is not supported",
+			other.ColumnVersion,
+		)
+	}
+
+	if _, err := FromString(other.Max); err != nil {
+		return fmt.Errorf(
+			"decimal max %s exceeds supported max %s",
+			other.Max,
+			features.Max,
+		)
+	}
+
+	return nil
+}
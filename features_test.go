@@ -0,0 +1,65 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeatures_DescribesBuild(t *testing.T) {
+	test := assert.New(t)
+
+	features := Features()
+
+	test.Equal("uint64", features.Backend)
+	test.Equal(64, features.Bits)
+	test.Equal(8, features.MaxPlaces)
+	test.Equal("99999999999.99999999", features.Max)
+	test.False(features.Signed)
+	test.Equal(1, features.ColumnVersion)
+	test.Equal(latencyEnabled, features.Latency)
+	test.Equal("half-even", features.Rounding)
+
+	data, err := json.Marshal(features)
+	test.NoError(err)
+
+	var decoded FeatureSet
+	test.NoError(json.Unmarshal(data, &decoded))
+	test.Equal(features, decoded)
+}
+
+func TestFeatures_ReflectsDefaultPolicy(t *testing.T) {
+	test := assert.New(t)
+	defer resetDefaultPolicy()
+
+	test.NoError(SetDefaultPolicy(Policy{Rounding: RoundUp}))
+	test.Equal("up", Features().Rounding)
+}
+
+func TestFeatureSet_Compatible(t *testing.T) {
+	test := assert.New(t)
+
+	features := Features()
+	test.NoError(features.Compatible(features))
+
+	other := features
+	other.Latency = !other.Latency
+	other.Rounding = "down"
+	other.ColumnVersion = 0
+	test.NoError(features.Compatible(other))
+
+	for _, change := range []func(*FeatureSet){
+		func(other *FeatureSet) { other.Backend = "int128" },
+		func(other *FeatureSet) { other.Bits = 128 },
+		func(other *FeatureSet) { other.MaxPlaces = 18 },
+		func(other *FeatureSet) { other.Max = "999999999999.0" },
+		func(other *FeatureSet) { other.Signed = true },
+		func(other *FeatureSet) { other.ColumnVersion++ },
+	} {
+		other := features
+		change(&other)
+
+		test.Error(features.Compatible(other))
+	}
+}
@@ -0,0 +1,180 @@
+package decimal
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Liquidity is side of trade with respect to order book, which determines
+// fee rate.
+type Liquidity int
+
+const (
+	// LiquidityMaker is order which adds liquidity to order book.
+	LiquidityMaker Liquidity = iota
+
+	// LiquidityTaker is order which removes liquidity from order book.
+	LiquidityTaker
+)
+
+// String returns name of liquidity side.
+func (liquidity Liquidity) String() string {
+	switch liquidity {
+	case LiquidityMaker:
+		return "maker"
+	case LiquidityTaker:
+		return "taker"
+	default:
+		return fmt.Sprintf("Liquidity(%d)", int(liquidity))
+	}
+}
+
+// FeeRates contains fee rates of single market as fractions of amount, e.g.
+// 0.001 for 0.1%.
+type FeeRates struct {
+	Maker Decimal
+	Taker Decimal
+}
+
+// FeeSchedule contains fee rates per market. Fees are rounded to configured
+// number of places up by default, so house never undercharges; DefaultPolicy()
+// isn't consulted.
+//
+// Example:
+//	schedule, err := decimal.NewFeeSchedule(8, rates)
+//	fees, err := schedule.Market("btcusd")
+//	fees.TakerFee(amount)
+//	fees.TakerNetAmount(amount)
+type FeeSchedule struct {
+	places int
+	mode   RoundingMode
+	rates  map[string]FeeRates
+}
+
+// NewFeeSchedule returns FeeSchedule with given rates keyed by market, which
+// rounds fees up to given number of places. Given map is copied. Function
+// will return error if number of places is not in range from 0 to 8.
+func NewFeeSchedule(places int, rates map[string]FeeRates) (FeeSchedule, error) {
+	if places < 0 || places > MaxPointsFractional {
+		return FeeSchedule{}, fmt.Errorf(
+			"number of places should be from 0 to %d: %d",
+			MaxPointsFractional,
+			places,
+		)
+	}
+
+	schedule := FeeSchedule{
+		places: places,
+		mode:   RoundUp,
+		rates:  make(map[string]FeeRates, len(rates)),
+	}
+
+	for market, rate := range rates {
+		schedule.rates[market] = rate
+	}
+
+	return schedule, nil
+}
+
+// Rounding returns copy of FeeSchedule which rounds fees with given mode.
+func (schedule FeeSchedule) Rounding(mode RoundingMode) FeeSchedule {
+	schedule.mode = mode
+	return schedule
+}
+
+// Markets returns markets of schedule, sorted.
+func (schedule FeeSchedule) Markets() []string {
+	markets := make([]string, 0, len(schedule.rates))
+	for market := range schedule.rates {
+		markets = append(markets, market)
+	}
+
+	sort.Strings(markets)
+
+	return markets
+}
+
+// Market returns fees of given market or error if market is not in
+// schedule.
+func (schedule FeeSchedule) Market(market string) (MarketFees, error) {
+	rates, ok := schedule.rates[market]
+	if !ok {
+		return MarketFees{}, fmt.Errorf("fee schedule has no market %q", market)
+	}
+
+	return MarketFees{
+		Market: market,
+		Rates:  rates,
+		scale:  WithMaxScale(schedule.places).Rounding(schedule.mode),
+	}, nil
+}
+
+// MarketFees computes fees of single market from FeeSchedule.
+type MarketFees struct {
+	Market string
+	Rates  FeeRates
+
+	scale Scale
+}
+
+// MakerFee returns fee charged on given amount of maker order.
+func (fees MarketFees) MakerFee(amount Decimal) (Decimal, error) {
+	return fees.Fee(amount, LiquidityMaker)
+}
+
+// TakerFee returns fee charged on given amount of taker order.
+func (fees MarketFees) TakerFee(amount Decimal) (Decimal, error) {
+	return fees.Fee(amount, LiquidityTaker)
+}
+
+// Fee returns fee charged on given amount of order with given liquidity.
+// Fee is computed exactly and rounded once with rounding of schedule.
+// Method will return error on unknown liquidity side or if fee can't be
+// stored in Decimal type.
+func (fees MarketFees) Fee(amount Decimal, liquidity Liquidity) (Decimal, error) {
+	var rate Decimal
+
+	switch liquidity {
+	case LiquidityMaker:
+		rate = fees.Rates.Maker
+	case LiquidityTaker:
+		rate = fees.Rates.Taker
+	default:
+		return 0, fmt.Errorf("unknown liquidity side: %s", liquidity)
+	}
+
+	return fees.scale.Multiply(amount, rate)
+}
+
+// MakerNetAmount returns given amount less fee of maker order.
+func (fees MarketFees) MakerNetAmount(amount Decimal) (Decimal, error) {
+	return fees.NetAmount(amount, LiquidityMaker)
+}
+
+// TakerNetAmount returns given amount less fee of taker order.
+func (fees MarketFees) TakerNetAmount(amount Decimal) (Decimal, error) {
+	return fees.NetAmount(amount, LiquidityTaker)
+}
+
+// NetAmount returns given amount less fee of order with given liquidity, see
+// Fee(). Liquidity is required since market has separate maker and taker
+// rates and net amount depends on which one applies; MakerNetAmount() and
+// TakerNetAmount() fix it. Method will return error if fee exceeds amount.
+func (fees MarketFees) NetAmount(amount Decimal, liquidity Liquidity) (Decimal, error) {
+	fee, err := fees.Fee(amount, liquidity)
+	if err != nil {
+		return 0, err
+	}
+
+	if fee > amount {
+		return 0, fmt.Errorf(
+			"%s fee %s exceeds amount %s in market %s",
+			liquidity,
+			fee.String(),
+			amount.String(),
+			fees.Market,
+		)
+	}
+
+	return amount - fee, nil
+}
@@ -0,0 +1,115 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestFeeSchedule(t *testing.T, places int) FeeSchedule {
+	schedule, err := NewFeeSchedule(places, map[string]FeeRates{
+		"btcusd": {
+			Maker: Must(FromString("0.001")),
+			Taker: Must(FromString("0.0025")),
+		},
+		"ethusd": {Taker: Must(FromString("0.002"))},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return schedule
+}
+
+func TestFeeSchedule_RoundsFeesUp(t *testing.T) {
+	test := assert.New(t)
+
+	fees, err := newTestFeeSchedule(t, 2).Market("btcusd")
+	test.NoError(err)
+	test.Equal("btcusd", fees.Market)
+
+	fee, err := fees.MakerFee(Must(FromString("1234.5")))
+	test.NoError(err)
+	test.Equal("1.24000000", fee.String())
+
+	fee, err = fees.TakerFee(Must(FromString("1234.5")))
+	test.NoError(err)
+	test.Equal("3.09000000", fee.String())
+
+	fee, err = fees.TakerFee(Decimal(1))
+	test.NoError(err)
+	test.Equal("0.01000000", fee.String())
+
+	fee, err = fees.TakerFee(0)
+	test.NoError(err)
+	test.Equal(Decimal(0), fee)
+}
+
+func TestFeeSchedule_Rounding(t *testing.T) {
+	test := assert.New(t)
+
+	fees, err := newTestFeeSchedule(t, 2).Rounding(RoundHalfEven).Market("btcusd")
+	test.NoError(err)
+
+	fee, err := fees.TakerFee(Must(FromString("1234.5")))
+	test.NoError(err)
+	test.Equal("3.09000000", fee.String())
+
+	fee, err = fees.MakerFee(Must(FromString("1234.5")))
+	test.NoError(err)
+	test.Equal("1.23000000", fee.String())
+}
+
+func TestMarketFees_NetAmount(t *testing.T) {
+	test := assert.New(t)
+
+	fees, err := newTestFeeSchedule(t, 8).Market("ethusd")
+	test.NoError(err)
+
+	net, err := fees.NetAmount(Must(FromString("100.0")), LiquidityTaker)
+	test.NoError(err)
+	test.Equal("99.80000000", net.String())
+
+	net, err = fees.NetAmount(Must(FromString("100.0")), LiquidityMaker)
+	test.NoError(err)
+	test.Equal("100.00000000", net.String())
+
+	net, err = fees.TakerNetAmount(Must(FromString("100.0")))
+	test.NoError(err)
+	test.Equal("99.80000000", net.String())
+
+	net, err = fees.MakerNetAmount(Must(FromString("100.0")))
+	test.NoError(err)
+	test.Equal("100.00000000", net.String())
+
+	_, err = fees.NetAmount(Must(FromString("100.0")), Liquidity(42))
+	test.Error(err)
+
+	fees, err = newTestFeeSchedule(t, 0).Market("ethusd")
+	test.NoError(err)
+
+	_, err = fees.NetAmount(Decimal(1), LiquidityTaker)
+	test.Error(err)
+	test.Contains(err.Error(), "exceeds amount")
+}
+
+func TestFeeSchedule_Markets(t *testing.T) {
+	test := assert.New(t)
+
+	schedule := newTestFeeSchedule(t, 8)
+	test.Equal([]string{"btcusd", "ethusd"}, schedule.Markets())
+
+	_, err := schedule.Market("xrpusd")
+	test.Error(err)
+
+	_, err = NewFeeSchedule(9, nil)
+	test.Error(err)
+}
+
+func TestLiquidity_String(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("maker", LiquidityMaker.String())
+	test.Equal("taker", LiquidityTaker.String())
+	test.Equal("Liquidity(42)", Liquidity(42).String())
+}
@@ -0,0 +1,27 @@
+package decimal
+
+// Fingerprint returns stable 64-bit hash of value, e.g. for Bloom filters
+// and deduplication.
+//
+// Hash is defined in terms of value itself rather than its representation,
+// so it will not change if Decimal gets different backing type:
+//	I, F = integer part, fractional part in units of 0.00000001
+//	fingerprint = mix(mix(I) + F) (mod 2^64)
+//
+// where mix is single step of SplitMix64:
+//	z = z + 0x9e3779b97f4a7c15
+//	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+//	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+//	z = z ^ (z >> 31)
+func (decimal Decimal) Fingerprint() uint64 {
+	integer, fractional := decimal.Split()
+
+	return mix64(mix64(integer) + fractional)
+}
+
+func mix64(z uint64) uint64 {
+	z += 0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
@@ -0,0 +1,41 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_Fingerprint_IsStable(t *testing.T) {
+	test := assert.New(t)
+
+	// Values are part of documented contract and must never change.
+	test.Equal(uint64(0xa706dd2f4d197e6f), Decimal(0).Fingerprint())
+	test.Equal(
+		uint64(0xd3f378a77ed160db),
+		Must(FromString("1.5")).Fingerprint(),
+	)
+	test.Equal(
+		uint64(0xeff48340d5254946),
+		Must(FromString("99999999999.99999999")).Fingerprint(),
+	)
+}
+
+func TestDecimal_Fingerprint_DistinguishesNeighbours(t *testing.T) {
+	test := assert.New(t)
+
+	seen := map[uint64]Decimal{}
+	for value := Decimal(0); value < 1000; value++ {
+		fingerprint := value.Fingerprint()
+
+		_, ok := seen[fingerprint]
+		test.False(ok, value.String())
+
+		seen[fingerprint] = value
+	}
+
+	test.NotEqual(
+		Must(FromString("1.0")).Fingerprint(),
+		Must(FromString("0.00000001")).Fingerprint(),
+	)
+}
@@ -0,0 +1,313 @@
+
+package decimal
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// FixedScale declares number of digits after decimal point of Fixed type.
+// Implementations should be empty structs: scale is part of type, so values
+// of different scales can't be mixed accidentally. Places should be from 0
+// to 18.
+type FixedScale interface {
+	Places() int
+}
+
+// Places2 is scale of 2 digits after decimal point, e.g. for fiat amounts.
+type Places2 struct{}
+
+// Places returns 2.
+func (Places2) Places() int { return 2 }
+
+// Places8 is scale of 8 digits after decimal point, same as of Decimal type.
+type Places8 struct{}
+
+// Places returns 8.
+func (Places8) Places() int { return 8 }
+
+// Places18 is scale of 18 digits after decimal point, e.g. for ERC-20 token
+// amounts.
+type Places18 struct{}
+
+// Places returns 18.
+func (Places18) Places() int { return 18 }
+
+// Fixed represents non-negative fixed-point number with number of digits
+// after decimal point given by its scale. It's stored as 128-bit number of
+// units, so it holds values up to 340282366920938463463374607431768211455
+// units, e.g. 340282366920938463463.374607431768211455 at 18 places.
+//
+// Example:
+//	type USD = decimal.Fixed[decimal.Places2]
+//	type Wei = decimal.Fixed[decimal.Places18]
+//	price, err := decimal.ParseFixed[decimal.Places2]("19.99")
+type Fixed[S FixedScale] struct {
+	hi, lo uint64
+}
+
+// fixedPlaces returns number of places of scale S. It panics if number of
+// places is not in range from 0 to 18.
+func fixedPlaces[S FixedScale]() int {
+	var scale S
+
+	places := scale.Places()
+	if places < 0 || places > 18 {
+		panic(fmt.Sprintf("number of places should be from 0 to 18: %d", places))
+	}
+
+	return places
+}
+
+// fixedFactor returns 10 to the power of number of places of scale S.
+func fixedFactor[S FixedScale]() *big.Int {
+	return new(big.Int).SetUint64(powers[fixedPlaces[S]()])
+}
+
+// fixedFromBig returns given number of units as Fixed and false if it
+// doesn't fit into 128 bits.
+func fixedFromBig[S FixedScale](units *big.Int) (Fixed[S], bool) {
+	if units.Sign() < 0 || units.BitLen() > 128 {
+		return Fixed[S]{}, false
+	}
+
+	var low big.Int
+	low.SetUint64(^uint64(0))
+	low.And(&low, units)
+
+	return Fixed[S]{
+		hi: new(big.Int).Rsh(units, 64).Uint64(),
+		lo: low.Uint64(),
+	}, true
+}
+
+// units returns value as number of units.
+func (fixed Fixed[S]) units() *big.Int {
+	units := new(big.Int).SetUint64(fixed.hi)
+	units.Lsh(units, 64)
+
+	return units.Or(units, new(big.Int).SetUint64(fixed.lo))
+}
+
+// ParseFixed returns Fixed parsed from string input, e.g. "19.99". Decimal
+// point and fractional part are optional. Function will return error if
+// input has more significant places than scale allows or value can't be
+// stored in Fixed type.
+func ParseFixed[S FixedScale](value string) (Fixed[S], error) {
+	var fixed Fixed[S]
+	err := fixed.Scan(value)
+	return fixed, err
+}
+
+// FixedFromDecimal returns given Decimal converted to scale S and rounded
+// with given mode.
+func FixedFromDecimal[S FixedScale](value Decimal, mode RoundingMode) Fixed[S] {
+	numerator := new(big.Int).Mul(bigDecimal(value), fixedFactor[S]())
+
+	// Decimal type holds less than 2^64 units, so result always fits.
+	fixed, _ := fixedFromBig[S](
+		mode.applyPlaces("fixed.convert", numerator, bigFractional, fixedPlaces[S]()),
+	)
+
+	return fixed
+}
+
+// Places returns number of digits after decimal point of scale.
+func (fixed Fixed[S]) Places() int {
+	return fixedPlaces[S]()
+}
+
+// IsZero reports whether value is zero.
+func (fixed Fixed[S]) IsZero() bool {
+	return fixed.hi == 0 && fixed.lo == 0
+}
+
+// Cmp returns -1, 0 or 1 if current value is less than, equal to or greater
+// than other value.
+func (fixed Fixed[S]) Cmp(other Fixed[S]) int {
+	if fixed.hi != other.hi {
+		return compare(fixed.hi, other.hi)
+	}
+
+	return compare(fixed.lo, other.lo)
+}
+
+// Add returns sum of current value and given addend. Method will return
+// error if result can't be stored in Fixed type.
+func (fixed Fixed[S]) Add(addend Fixed[S]) (Fixed[S], error) {
+	sum, ok := fixedFromBig[S](new(big.Int).Add(fixed.units(), addend.units()))
+	if !ok {
+		return Fixed[S]{}, fmt.Errorf(
+			"fixed type can't hold result of addition: %s + %s",
+			fixed.String(),
+			addend.String(),
+		)
+	}
+
+	return sum, nil
+}
+
+// Sub returns result of subtracting given subtrahend from current value.
+// Method will return error if subtrahend is greater than current value.
+func (fixed Fixed[S]) Sub(subtrahend Fixed[S]) (Fixed[S], error) {
+	difference, ok := fixedFromBig[S](
+		new(big.Int).Sub(fixed.units(), subtrahend.units()),
+	)
+	if !ok {
+		return Fixed[S]{}, fmt.Errorf(
+			"fixed type can't hold negative result of subtraction: %s - %s",
+			fixed.String(),
+			subtrahend.String(),
+		)
+	}
+
+	return difference, nil
+}
+
+// Multiply returns product of current value and given multiplier rounded
+// to places of scale with given mode. Method will return error if result
+// can't be stored in Fixed type.
+func (fixed Fixed[S]) Multiply(multiplier Fixed[S], mode RoundingMode) (Fixed[S], error) {
+	numerator := new(big.Int).Mul(fixed.units(), multiplier.units())
+
+	product, ok := fixedFromBig[S](
+		mode.applyPlaces("fixed.multiply", numerator, fixedFactor[S](), fixedPlaces[S]()),
+	)
+	if !ok {
+		return Fixed[S]{}, fmt.Errorf(
+			"fixed type can't hold result of multiplication: %s × %s",
+			fixed.String(),
+			multiplier.String(),
+		)
+	}
+
+	return product, nil
+}
+
+// Div returns result of dividing current value by given divisor rounded to
+// places of scale with given mode. Method will return error if divisor is
+// zero or result can't be stored in Fixed type.
+func (fixed Fixed[S]) Div(divisor Fixed[S], mode RoundingMode) (Fixed[S], error) {
+	if divisor.IsZero() {
+		return Fixed[S]{}, fmt.Errorf(
+			"fixed type can't be divided by zero: %s / 0", fixed.String(),
+		)
+	}
+
+	numerator := new(big.Int).Mul(fixed.units(), fixedFactor[S]())
+
+	quotient, ok := fixedFromBig[S](
+		mode.applyPlaces("fixed.div", numerator, divisor.units(), fixedPlaces[S]()),
+	)
+	if !ok {
+		return Fixed[S]{}, fmt.Errorf(
+			"fixed type can't hold result of division: %s / %s",
+			fixed.String(),
+			divisor.String(),
+		)
+	}
+
+	return quotient, nil
+}
+
+// Decimal returns value converted to Decimal and rounded to 8 places with
+// given mode. Method will return error if result can't be stored in
+// Decimal type.
+func (fixed Fixed[S]) Decimal(mode RoundingMode) (Decimal, error) {
+	numerator := new(big.Int).Mul(fixed.units(), bigFractional)
+
+	value, ok := fromBig(mode.apply("fixed.decimal", numerator, fixedFactor[S](), 1))
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold fixed value: %s", fixed.String(),
+		)
+	}
+
+	return value, nil
+}
+
+// String returns string representation of Fixed type, with trailing zeroes
+// to pad to places of scale.
+//
+// Example:
+//	decimal.ParseFixed[decimal.Places2]("1.5")
+//	fixed.String() // will return "1.50"
+func (fixed Fixed[S]) String() string {
+	places := fixedPlaces[S]()
+
+	digits := fixed.units().String()
+	if places == 0 {
+		return digits
+	}
+
+	if len(digits) <= places {
+		digits = strings.Repeat("0", places-len(digits)+1) + digits
+	}
+
+	return digits[:len(digits)-places] + "." + digits[len(digits)-places:]
+}
+
+// Scan parses value from given string/bytes representation and return error
+// if value can't be stored in Fixed type.
+// Used in SQL communication.
+func (fixed *Fixed[S]) Scan(data interface{}) error {
+	switch data := data.(type) {
+	case []byte:
+		return fixed.Scan(string(data))
+
+	case string:
+		places := fixedPlaces[S]()
+
+		integer, fractional, period := strings.Cut(data, ".")
+		if !isDigits(integer) || (period && !isDigits(fractional)) {
+			return fmt.Errorf("fixed type can't be parsed: %q", data)
+		}
+
+		fractional = strings.TrimRight(fractional, "0")
+		if len(fractional) > places {
+			return fmt.Errorf(
+				"fixed type can't hold more than %d places: %q", places, data,
+			)
+		}
+
+		units, _ := new(big.Int).SetString(
+			integer+fractional+strings.Repeat("0", places-len(fractional)),
+			10,
+		)
+
+		result, ok := fixedFromBig[S](units)
+		if !ok {
+			return fmt.Errorf("fixed type can't hold value: %q", data)
+		}
+
+		*fixed = result
+
+		return nil
+
+	default:
+		return fmt.Errorf(
+			"fixed type expected to be []byte, but %T received",
+			data,
+		)
+	}
+}
+
+// Value returns string representation of Fixed type.
+// Used in SQL communication.
+func (fixed Fixed[S]) Value() (driver.Value, error) {
+	return fixed.String(), nil
+}
+
+// MarshalText returns string representation as []byte type.
+// Used in json marshaling/unmarshaling.
+func (fixed Fixed[S]) MarshalText() ([]byte, error) {
+	return []byte(fixed.String()), nil
+}
+
+// UnmarshalText calls Scan() method to read Fixed type.
+// Used in json marshaling/unmarshaling.
+func (fixed *Fixed[S]) UnmarshalText(data []byte) error {
+	return fixed.Scan(string(data))
+}
@@ -0,0 +1,178 @@
+
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type places0 struct{}
+
+func (places0) Places() int { return 0 }
+
+type places19 struct{}
+
+func (places19) Places() int { return 19 }
+
+func mustFixed[S FixedScale](value string) Fixed[S] {
+	fixed, err := ParseFixed[S](value)
+	if err != nil {
+		panic(err)
+	}
+
+	return fixed
+}
+
+func TestParseFixed_HonoursScale(t *testing.T) {
+	test := assert.New(t)
+
+	for value, expected := range map[string]string{
+		"19.99":  "19.99",
+		"1.5":    "1.50",
+		"1.5000": "1.50",
+		"7":      "7.00",
+		"0.01":   "0.01",
+		"0.0":    "0.00",
+	} {
+		actual, err := ParseFixed[Places2](value)
+		test.NoError(err, value)
+		test.Equal(expected, actual.String(), value)
+	}
+
+	for _, value := range []string{"", ".5", "5.", "1.005", "-1.0", "+1.0", "1.0.0", "1a"} {
+		_, err := ParseFixed[Places2](value)
+		test.Error(err, value)
+	}
+
+	wei, err := ParseFixed[Places18]("340282366920938463463.374607431768211455")
+	test.NoError(err)
+	test.Equal("340282366920938463463.374607431768211455", wei.String())
+	test.Equal(18, wei.Places())
+
+	_, err = ParseFixed[Places18]("340282366920938463463.374607431768211456")
+	test.Error(err)
+
+	test.Equal("0.000000000000000001", mustFixed[Places18]("0.000000000000000001").String())
+	test.Equal("42", mustFixed[places0]("42").String())
+
+	test.Panics(func() { _ = Fixed[places19]{}.String() })
+}
+
+func TestFixed_Arithmetic(t *testing.T) {
+	test := assert.New(t)
+
+	a := mustFixed[Places2]("10.25")
+	b := mustFixed[Places2]("3.0")
+
+	sum, err := a.Add(b)
+	test.NoError(err)
+	test.Equal("13.25", sum.String())
+
+	difference, err := a.Sub(b)
+	test.NoError(err)
+	test.Equal("7.25", difference.String())
+
+	_, err = b.Sub(a)
+	test.Error(err)
+
+	product, err := a.Multiply(mustFixed[Places2]("0.5"), RoundHalfEven)
+	test.NoError(err)
+	test.Equal("5.12", product.String())
+
+	product, err = a.Multiply(mustFixed[Places2]("0.5"), RoundUp)
+	test.NoError(err)
+	test.Equal("5.13", product.String())
+
+	quotient, err := a.Div(b, RoundDown)
+	test.NoError(err)
+	test.Equal("3.41", quotient.String())
+
+	_, err = a.Div(Fixed[Places2]{}, RoundDown)
+	test.Error(err)
+
+	max := mustFixed[Places18]("340282366920938463463.374607431768211455")
+
+	_, err = max.Add(mustFixed[Places18]("0.000000000000000001"))
+	test.Error(err)
+
+	_, err = max.Multiply(mustFixed[Places18]("2.0"), RoundDown)
+	test.Error(err)
+
+	test.Equal(1, a.Cmp(b))
+	test.Equal(-1, b.Cmp(a))
+	test.Equal(0, a.Cmp(a))
+	test.Equal(1, max.Cmp(mustFixed[Places18]("1.0")))
+	test.True(Fixed[Places2]{}.IsZero())
+}
+
+func TestFixed_DecimalConversion(t *testing.T) {
+	test := assert.New(t)
+
+	value := Must(FromString("1.23456789"))
+
+	test.Equal("1.23", FixedFromDecimal[Places2](value, RoundDown).String())
+	test.Equal("1.24", FixedFromDecimal[Places2](value, RoundUp).String())
+	test.Equal("1.234567890000000000", FixedFromDecimal[Places18](value, RoundDown).String())
+
+	converted, err := mustFixed[Places18]("1.234567895").Decimal(RoundHalfEven)
+	test.NoError(err)
+	test.Equal("1.23456790", converted.String())
+
+	_, err = mustFixed[Places18]("100000000000.0").Decimal(RoundDown)
+	test.Error(err)
+}
+
+func TestFixed_SQLAndJSON(t *testing.T) {
+	test := assert.New(t)
+
+	var fixed Fixed[Places2]
+	test.NoError(fixed.Scan([]byte("12.30")))
+	test.Equal("12.30", fixed.String())
+
+	value, err := fixed.Value()
+	test.NoError(err)
+	test.Equal("12.30", value)
+
+	test.Error(fixed.Scan(42))
+
+	data, err := json.Marshal(map[string]Fixed[Places2]{"price": fixed})
+	test.NoError(err)
+	test.Equal(`{"price":"12.30"}`, string(data))
+
+	var decoded map[string]Fixed[Places2]
+	test.NoError(json.Unmarshal(data, &decoded))
+	test.Equal(fixed, decoded["price"])
+}
+
+func TestFixed_ReportsInexactRounding(t *testing.T) {
+	test := assert.New(t)
+
+	records := recordInexact(t)
+
+	FixedFromDecimal[Places2](Must(FromString("1.235")), RoundHalfUp)
+
+	_, err := mustFixed[Places2]("1.05").Multiply(mustFixed[Places2]("1.05"), RoundDown)
+	test.NoError(err)
+
+	_, err = mustFixed[Places2]("1").Div(mustFixed[Places2]("3"), RoundDown)
+	test.NoError(err)
+
+	_, err = mustFixed[Places18]("0.000000000000000001").Decimal(RoundDown)
+	test.NoError(err)
+
+	_, err = mustFixed[Places18]("0.000000000000000001").Multiply(
+		mustFixed[Places18]("0.5"),
+		RoundDown,
+	)
+	test.NoError(err)
+
+	test.Equal([]inexactRecord{
+		{"fixed.convert", Must(FromString("0.005"))},
+		{"fixed.multiply", Must(FromString("0.0025"))},
+		{"fixed.div", Must(FromString("0.00333334"))},
+		{"fixed.decimal", 1},
+		{"fixed.multiply", 1},
+	}, *records)
+}
@@ -0,0 +1,126 @@
+package decimal
+
+import (
+	"strings"
+)
+
+// Formatter converts Decimal to text representation. It allows to plug
+// custom formatting (e.g. per white-label exchange) into code which renders
+// amounts, without changing String() used in SQL and JSON.
+type Formatter interface {
+	Format(value Decimal) string
+}
+
+// FormatterFunc is adapter allowing to use ordinary function as Formatter.
+type FormatterFunc func(value Decimal) string
+
+// Format calls function itself.
+func (format FormatterFunc) Format(value Decimal) string {
+	return format(value)
+}
+
+// DefaultFormatter formats values the same way as String() does.
+var DefaultFormatter Formatter = NumberFormatter{Places: MaxPointsFractional}
+
+// Format returns value formatted by given Formatter, or by
+// DefaultFormatter if it's nil.
+func (decimal Decimal) Format(formatter Formatter) string {
+	if formatter == nil {
+		formatter = DefaultFormatter
+	}
+
+	return formatter.Format(decimal)
+}
+
+// NumberFormatter is configurable Formatter for plain decimal notation.
+//
+// Example:
+//	formatter := decimal.NumberFormatter{Places: 2, Point: ",", Group: " "}
+//	formatter.Format(decimal.Must(decimal.FromString("1234567.891")))
+//	// will return "1 234 567,89"
+type NumberFormatter struct {
+	// Places is number of digits after decimal point, from 0 to 8. Values
+	// outside of that range are clamped.
+	Places int
+
+	// Rounding is used when value has more than Places digits after
+	// decimal point. Values which can't be rounded are truncated.
+	Rounding RoundingMode
+
+	// Trim removes trailing zeroes after decimal point, and decimal point
+	// itself if nothing is left after it.
+	Trim bool
+
+	// Point separates integer and fractional parts, "." if empty.
+	Point string
+
+	// Group separates groups of three digits of integer part, digits are
+	// not grouped if empty.
+	Group string
+
+	// OmitLeadingZero removes zero integer part of values below one, e.g.
+	// ".50000000", as required by some fixed-width protocols. Zero without
+	// fractional digits is still formatted as "0".
+	OmitLeadingZero bool
+}
+
+// Format returns value formatted according to formatter options.
+func (formatter NumberFormatter) Format(value Decimal) string {
+	places := formatter.Places
+	if places < 0 {
+		places = 0
+	}
+
+	if places > MaxPointsFractional {
+		places = MaxPointsFractional
+	}
+
+	rounded, err := value.Round(places, formatter.Rounding)
+	if err != nil {
+		rounded, _ = value.Round(places, RoundDown)
+	}
+
+	text := rounded.String()
+	period := strings.IndexByte(text, '.')
+
+	integer := text[:period]
+	fraction := text[period+1 : period+1+places]
+
+	if formatter.Trim {
+		fraction = strings.TrimRight(fraction, "0")
+	}
+
+	if formatter.Group != "" {
+		integer = group(integer, formatter.Group)
+	}
+
+	if fraction == "" {
+		return integer
+	}
+
+	if formatter.OmitLeadingZero && integer == "0" {
+		integer = ""
+	}
+
+	point := formatter.Point
+	if point == "" {
+		point = "."
+	}
+
+	return integer + point + fraction
+}
+
+// group inserts separator between groups of three digits.
+func group(digits, separator string) string {
+	var builder strings.Builder
+
+	for i := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			builder.WriteString(separator)
+		}
+
+		builder.WriteByte(digits[i])
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,85 @@
+package decimal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_Format_UsesDefaultFormatter(t *testing.T) {
+	test := assert.New(t)
+
+	for _, value := range []string{
+		"0.0", "0.1", "1.5", "99999999999.99999999",
+	} {
+		number := Must(FromString(value))
+		test.Equal(number.String(), number.Format(nil), value)
+		test.Equal(number.String(), DefaultFormatter.Format(number), value)
+	}
+}
+
+func TestDecimal_Format_AcceptsCustomFormatter(t *testing.T) {
+	test := assert.New(t)
+
+	formatter := FormatterFunc(func(value Decimal) string {
+		return strings.TrimRight(value.String(), "0") + " BTC"
+	})
+
+	test.Equal("1.5 BTC", Must(FromString("1.5")).Format(formatter))
+}
+
+func TestNumberFormatter_Format_AppliesOptions(t *testing.T) {
+	test := assert.New(t)
+
+	value := Must(FromString("1234567.891"))
+
+	for _, example := range []struct {
+		formatter NumberFormatter
+		expected  string
+	}{
+		{NumberFormatter{Places: 8}, "1234567.89100000"},
+		{NumberFormatter{Places: 2}, "1234567.89"},
+		{NumberFormatter{Places: 0, Rounding: RoundHalfUp}, "1234568"},
+		{NumberFormatter{Places: 8, Trim: true}, "1234567.891"},
+		{NumberFormatter{Places: 2, Point: ",", Group: " "}, "1 234 567,89"},
+		{NumberFormatter{Places: 2, Rounding: RoundUp, Group: ","}, "1,234,567.90"},
+		{NumberFormatter{Places: 42}, "1234567.89100000"},
+		{NumberFormatter{Places: -1}, "1234567"},
+	} {
+		test.Equal(example.expected, example.formatter.Format(value))
+	}
+}
+
+func TestNumberFormatter_Format_TruncatesWhenRoundingOverflows(t *testing.T) {
+	test := assert.New(t)
+
+	formatter := NumberFormatter{Places: 1, Rounding: RoundUp}
+
+	test.Equal(
+		"99999999999.9",
+		formatter.Format(Must(FromString("99999999999.99"))),
+	)
+}
+
+func TestNumberFormatter_Format_HandlesShortValues(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("0", NumberFormatter{Trim: true}.Format(0))
+	test.Equal("100", NumberFormatter{Group: ","}.Format(Must(FromString("100.0"))))
+	test.Equal("1,000", NumberFormatter{Group: ","}.Format(Must(FromString("1000.0"))))
+}
+
+func TestNumberFormatter_Format_OmitsLeadingZero(t *testing.T) {
+	test := assert.New(t)
+
+	formatter := NumberFormatter{Places: 8, OmitLeadingZero: true}
+
+	test.Equal(".50000000", formatter.Format(Must(FromString("0.5"))))
+	test.Equal(".00000000", formatter.Format(0))
+	test.Equal("1.50000000", formatter.Format(Must(FromString("1.5"))))
+	test.Equal("10.00000000", formatter.Format(Must(FromString("10.0"))))
+
+	formatter.Trim = true
+	test.Equal("0", formatter.Format(0))
+}
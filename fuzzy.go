@@ -0,0 +1,139 @@
+package decimal
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Fix is repair applied by ParseFuzzy() to make value parsable.
+type Fix int
+
+const (
+	// FixTrimmed means leading or trailing whitespace was removed.
+	FixTrimmed Fix = iota
+
+	// FixCurrency means currency symbol or code, e.g. "$" or "USD", was
+	// removed.
+	FixCurrency
+
+	// FixGrouping means digit grouping separators, e.g. "," in "1,234.5",
+	// were removed.
+	FixGrouping
+
+	// FixDecimalComma means decimal comma was replaced with decimal point.
+	FixDecimalComma
+
+	// FixDecimalPoint means decimal point was added to integer value.
+	FixDecimalPoint
+)
+
+// String returns description of repair.
+func (fix Fix) String() string {
+	switch fix {
+	case FixTrimmed:
+		return "trimmed whitespace"
+	case FixCurrency:
+		return "removed currency"
+	case FixGrouping:
+		return "removed digit grouping"
+	case FixDecimalComma:
+		return "replaced decimal comma"
+	case FixDecimalPoint:
+		return "added decimal point"
+	default:
+		return fmt.Sprintf("Fix(%d)", int(fix))
+	}
+}
+
+// ParseFuzzy parses value like ParseLenient() does after attempting common
+// repairs of human-entered values and returns repairs which were applied,
+// in order. Function will return error if value can't be parsed even after
+// repairs.
+//
+// Repairs are: removing whitespace, currency symbols and codes around
+// value, removing digit grouping and replacing decimal comma. If value
+// contains both "." and ",", the last one is decimal separator; single ","
+// is considered decimal comma, while several "," or "." are considered
+// grouping.
+//
+// Example:
+//	decimal.ParseFuzzy(" €1.234,5 ")
+//	// will return 1234.5, [FixTrimmed FixCurrency FixGrouping FixDecimalComma]
+func ParseFuzzy(value string) (Decimal, []Fix, error) {
+	var fixes []Fix
+
+	text := strings.TrimFunc(value, unicode.IsSpace)
+	if text != value {
+		fixes = append(fixes, FixTrimmed)
+	}
+
+	if stripped := stripCurrency(text); stripped != text {
+		text = stripped
+		fixes = append(fixes, FixCurrency)
+	}
+
+	grouped := strings.Map(func(char rune) rune {
+		if char == '_' || char == '\'' || unicode.IsSpace(char) {
+			return -1
+		}
+
+		return char
+	}, text)
+
+	comma := strings.LastIndexByte(grouped, ',')
+	period := strings.LastIndexByte(grouped, '.')
+	commas := strings.Count(grouped, ",")
+	periods := strings.Count(grouped, ".")
+
+	decimalComma := false
+	switch {
+	case comma >= 0 && period >= 0 && comma > period:
+		grouped = strings.ReplaceAll(grouped, ".", "")
+		decimalComma = true
+	case comma >= 0 && period >= 0:
+		grouped = strings.ReplaceAll(grouped, ",", "")
+	case commas > 1:
+		grouped = strings.ReplaceAll(grouped, ",", "")
+	case commas == 1:
+		decimalComma = true
+	case periods > 1:
+		grouped = strings.ReplaceAll(grouped, ".", "")
+	}
+
+	if grouped != text {
+		fixes = append(fixes, FixGrouping)
+	}
+	text = grouped
+
+	if decimalComma {
+		text = strings.Replace(text, ",", ".", 1)
+		fixes = append(fixes, FixDecimalComma)
+	}
+
+	if !strings.Contains(text, ".") && text != "" {
+		text += ".0"
+		fixes = append(fixes, FixDecimalPoint)
+	}
+
+	decimal, err := ParseLenient(text)
+	if err != nil {
+		return 0, fixes, fmt.Errorf(
+			"decimal type can't be parsed from %q: %s", value, err,
+		)
+	}
+
+	return decimal, fixes, nil
+}
+
+// stripCurrency removes currency symbols and letters around value, e.g. "$"
+// in "$10.5" or "BTC" in "10.5 BTC", as well as whitespace separating them.
+func stripCurrency(value string) string {
+	currency := func(char rune) bool {
+		return unicode.Is(unicode.Sc, char) || unicode.IsLetter(char)
+	}
+
+	text := strings.TrimFunc(value, currency)
+
+	return strings.TrimFunc(text, unicode.IsSpace)
+}
@@ -0,0 +1,53 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFuzzy_RepairsCommonMistakes(t *testing.T) {
+	test := assert.New(t)
+
+	for _, example := range []struct {
+		value    string
+		expected string
+		fixes    []Fix
+	}{
+		{"1.5", "1.50000000", nil},
+		{" 1.5\t", "1.50000000", []Fix{FixTrimmed}},
+		{"$10.25", "10.25000000", []Fix{FixCurrency}},
+		{"10.25 BTC", "10.25000000", []Fix{FixCurrency}},
+		{"1,234.5", "1234.50000000", []Fix{FixGrouping}},
+		{"1 234 567.5", "1234567.50000000", []Fix{FixGrouping}},
+		{"1.234.567", "1234567.00000000", []Fix{FixGrouping, FixDecimalPoint}},
+		{"0,5", "0.50000000", []Fix{FixDecimalComma}},
+		{
+			" €1.234,5 ", "1234.50000000",
+			[]Fix{FixTrimmed, FixCurrency, FixGrouping, FixDecimalComma},
+		},
+		{"42", "42.00000000", []Fix{FixDecimalPoint}},
+		{"$.5", "0.50000000", []Fix{FixCurrency}},
+	} {
+		actual, fixes, err := ParseFuzzy(example.value)
+		test.NoError(err, example.value)
+		test.Equal(example.expected, actual.String(), example.value)
+		test.Equal(example.fixes, fixes, example.value)
+	}
+}
+
+func TestParseFuzzy_ReturnsErrorOnGarbage(t *testing.T) {
+	test := assert.New(t)
+
+	for _, value := range []string{"", "USD", "1.2.3,4,5", "-1.5", "1.000000001"} {
+		_, _, err := ParseFuzzy(value)
+		test.Error(err, value)
+	}
+}
+
+func TestFix_String(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("replaced decimal comma", FixDecimalComma.String())
+	test.Equal("Fix(42)", Fix(42).String())
+}
@@ -0,0 +1,189 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// bidFormat describes IEEE 754-2008 decimal interchange format using binary
+// integer decimal (BID) encoding of coefficient.
+type bidFormat struct {
+	name           string
+	width          uint
+	coefficient    uint
+	bias           int
+	maxCoefficient uint64
+}
+
+var (
+	bid32 = bidFormat{
+		name:           "decimal32",
+		width:          32,
+		coefficient:    23,
+		bias:           101,
+		maxCoefficient: 9999999,
+	}
+
+	bid64 = bidFormat{
+		name:           "decimal64",
+		width:          64,
+		coefficient:    53,
+		bias:           398,
+		maxCoefficient: 9999999999999999,
+	}
+)
+
+// Decimal64 returns value encoded as IEEE 754-2008 decimal64 number with
+// BID encoding. Method will return error if value has more than 16
+// significant digits and can't be encoded without loosing precision.
+//
+// Value keeps exponent of -8 unless coefficient must be shortened to fit;
+// only trailing zeroes are dropped in that case.
+func (decimal Decimal) Decimal64() (uint64, error) {
+	return bid64.encode(decimal)
+}
+
+// Decimal32 returns value encoded as IEEE 754-2008 decimal32 number with
+// BID encoding. Method will return error if value has more than 7
+// significant digits and can't be encoded without loosing precision.
+func (decimal Decimal) Decimal32() (uint32, error) {
+	bits, err := bid32.encode(decimal)
+	return uint32(bits), err
+}
+
+// FromDecimal64 returns Decimal decoded from IEEE 754-2008 decimal64 number
+// with BID encoding. Function will return error if number is negative,
+// infinite, NaN or can't be stored in Decimal without loosing precision.
+func FromDecimal64(bits uint64) (Decimal, error) {
+	return bid64.decode(bits)
+}
+
+// FromDecimal32 returns Decimal decoded from IEEE 754-2008 decimal32 number
+// with BID encoding. Function will return error if number is negative,
+// infinite, NaN or can't be stored in Decimal without loosing precision.
+func FromDecimal32(bits uint32) (Decimal, error) {
+	return bid32.decode(uint64(bits))
+}
+
+func (format bidFormat) encode(decimal Decimal) (uint64, error) {
+	if uint64(decimal) >= Max {
+		return 0, fmt.Errorf(
+			"decimal type value is out of range: %d", uint64(decimal),
+		)
+	}
+
+	coefficient := decimal.Uint64()
+	exponent := -MaxPointsFractional
+
+	for coefficient > format.maxCoefficient {
+		if coefficient%10 != 0 {
+			return 0, fmt.Errorf(
+				"%s can't hold all significant digits of value: %s",
+				format.name,
+				decimal.String(),
+			)
+		}
+
+		coefficient /= 10
+		exponent++
+	}
+
+	biased := uint64(exponent + format.bias)
+
+	if coefficient < 1<<format.coefficient {
+		return biased<<format.coefficient | coefficient, nil
+	}
+
+	// Large coefficients have implicit 0b100 prefix, which frees two
+	// leading bits for "11" combination field marker.
+	short := format.coefficient - 2
+
+	return 3<<(format.width-3) |
+		biased<<short |
+		coefficient&(1<<short-1), nil
+}
+
+func (format bidFormat) decode(bits uint64) (Decimal, error) {
+	var (
+		negative    = bits>>(format.width-1)&1 == 1
+		combination = bits >> (format.width - 6) & 0x1f
+		exponent    uint64
+		coefficient uint64
+		mask        = uint64(1)<<(format.width-1) - 1
+		short       = format.coefficient - 2
+	)
+
+	switch {
+	case combination == 0x1e:
+		return 0, fmt.Errorf(
+			"decimal type can't hold %s infinity: %#x", format.name, bits,
+		)
+
+	case combination == 0x1f:
+		return 0, fmt.Errorf(
+			"decimal type can't hold %s NaN: %#x", format.name, bits,
+		)
+
+	case combination>>3 == 3:
+		exponent = bits & mask >> short & (mask >> (short + 2))
+		coefficient = 1<<(short+2) | bits&(1<<short-1)
+
+	default:
+		exponent = bits & mask >> format.coefficient
+		coefficient = bits & (1<<format.coefficient - 1)
+	}
+
+	// Non-canonical coefficients are interpreted as zero by the standard.
+	if coefficient > format.maxCoefficient {
+		coefficient = 0
+	}
+
+	if coefficient == 0 {
+		return 0, nil
+	}
+
+	if negative {
+		return 0, fmt.Errorf(
+			"decimal type can't hold negative %s value: %#x",
+			format.name,
+			bits,
+		)
+	}
+
+	for shift := int(exponent) - format.bias + MaxPointsFractional; shift != 0; {
+		if shift < 0 {
+			if coefficient%10 != 0 {
+				return 0, fmt.Errorf(
+					"decimal type can't hold fractional part of %s value: %#x",
+					format.name,
+					bits,
+				)
+			}
+
+			coefficient /= 10
+			shift++
+
+			continue
+		}
+
+		if coefficient >= Max/10 {
+			return 0, fmt.Errorf(
+				"decimal type can't hold integer part of %s value: %#x",
+				format.name,
+				bits,
+			)
+		}
+
+		coefficient *= 10
+		shift--
+	}
+
+	if coefficient >= Max {
+		return 0, fmt.Errorf(
+			"decimal type can't hold integer part of %s value: %#x",
+			format.name,
+			bits,
+		)
+	}
+
+	return Decimal(coefficient), nil
+}
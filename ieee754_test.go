@@ -0,0 +1,122 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromDecimal64_CanDecodeReferenceValues(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := FromDecimal64(0x31C0000000000001)
+	test.NoError(err)
+	test.Equal("1.00000000", actual.String())
+
+	actual, err = FromDecimal64(0x31A000000000000F)
+	test.NoError(err)
+	test.Equal("1.50000000", actual.String())
+
+	actual, err = FromDecimal64(0xB1C0000000000000)
+	test.NoError(err)
+	test.Equal("0.00000000", actual.String())
+}
+
+func TestFromDecimal64_ReturnsErrorOnSpecialValues(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := FromDecimal64(0x7800000000000000)
+	test.Error(err)
+	test.Contains(err.Error(), "infinity")
+
+	_, err = FromDecimal64(0x7C00000000000000)
+	test.Error(err)
+	test.Contains(err.Error(), "NaN")
+
+	_, err = FromDecimal64(0xB1C0000000000001)
+	test.Error(err)
+	test.Contains(err.Error(), "negative")
+}
+
+func TestFromDecimal64_ReturnsErrorOnOutOfRangeValues(t *testing.T) {
+	test := assert.New(t)
+
+	// 1E-9
+	_, err := FromDecimal64(uint64(398-9)<<53 | 1)
+	test.Error(err)
+	test.Contains(err.Error(), "can't hold fractional part")
+
+	// 1E11
+	_, err = FromDecimal64(uint64(398+11)<<53 | 1)
+	test.Error(err)
+	test.Contains(err.Error(), "can't hold integer part")
+
+	// 1E-20 × 10^20
+	actual, err := FromDecimal64(uint64(398-20)<<53 | 100000000000)
+	test.Error(err)
+	test.Equal(Decimal(0), actual)
+}
+
+func TestDecimal_Decimal64_RoundTrips(t *testing.T) {
+	test := assert.New(t)
+
+	for _, value := range []string{
+		"0.0",
+		"0.00000001",
+		"1.5",
+		"99999999.99999999",
+		"99999999999.0",
+		"12345678901.2345",
+	} {
+		expected := Must(FromString(value))
+
+		bits, err := expected.Decimal64()
+		test.NoError(err, value)
+
+		actual, err := FromDecimal64(bits)
+		test.NoError(err, value)
+		test.Equal(expected, actual, value)
+	}
+}
+
+func TestDecimal_Decimal64_UsesLargeCoefficientForm(t *testing.T) {
+	test := assert.New(t)
+
+	bits, err := Must(FromString("99999999.99999999")).Decimal64()
+	test.NoError(err)
+	test.Equal(uint64(3), bits>>61&3)
+}
+
+func TestDecimal_Decimal64_ReturnsErrorOnTooManyDigits(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Must(FromString("99999999999.99999999")).Decimal64()
+	test.Error(err)
+	test.Contains(err.Error(), "significant digits")
+}
+
+func TestDecimal_Decimal32_RoundTrips(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := FromDecimal32(0x32800001)
+	test.NoError(err)
+	test.Equal("1.00000000", actual.String())
+
+	for _, value := range []string{"0.0", "0.0000001", "9999999.0", "1.234567"} {
+		expected := Must(FromString(value))
+
+		bits, err := expected.Decimal32()
+		test.NoError(err, value)
+
+		actual, err := FromDecimal32(bits)
+		test.NoError(err, value)
+		test.Equal(expected, actual, value)
+	}
+}
+
+func TestDecimal_Decimal32_ReturnsErrorOnTooManyDigits(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Must(FromString("1.2345678")).Decimal32()
+	test.Error(err)
+}
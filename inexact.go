@@ -0,0 +1,107 @@
+package decimal
+
+import (
+	"math/big"
+	"sync/atomic"
+)
+
+// inexactHook holds function registered by OnInexact().
+var inexactHook atomic.Value
+
+// OnInexact registers function which is called whenever rounding operation
+// of this package discards part of value, e.g. to collect metrics of
+// cumulative precision loss. Passing nil removes registered function.
+//
+// Function receives name of operation and absolute difference between exact
+// and rounded result, rounded up to 0.00000001, so loss of a fraction of
+// 0.00000001 is reported as 0.00000001. It's called synchronously from
+// goroutine performing operation, so it should be fast and safe for
+// concurrent use.
+func OnInexact(hook func(op string, lost Decimal)) {
+	inexactHook.Store(hook)
+}
+
+func reportInexact(op string, lost Decimal) {
+	hook, _ := inexactHook.Load().(func(string, Decimal))
+	if hook != nil && lost != 0 {
+		hook(op, lost)
+	}
+}
+
+// apply returns numerator / denominator rounded with given mode like
+// divide() does and reports discarded part of value as inexact operation.
+// Unit is number of 0.00000001 in single unit of quotient.
+func (mode RoundingMode) apply(
+	op string,
+	numerator, denominator *big.Int,
+	unit uint64,
+) *big.Int {
+	return mode.applyScaled(
+		op,
+		numerator,
+		denominator,
+		new(big.Int).SetUint64(unit),
+		big.NewInt(1),
+	)
+}
+
+// applyPlaces is like apply() for quotient with given number of digits after
+// decimal point, which may be more than 8 or negative.
+func (mode RoundingMode) applyPlaces(
+	op string,
+	numerator, denominator *big.Int,
+	places int,
+) *big.Int {
+	if places <= MaxPointsFractional {
+		return mode.applyScaled(
+			op,
+			numerator,
+			denominator,
+			pow10(MaxPointsFractional-places),
+			big.NewInt(1),
+		)
+	}
+
+	return mode.applyScaled(
+		op,
+		numerator,
+		denominator,
+		big.NewInt(1),
+		pow10(places-MaxPointsFractional),
+	)
+}
+
+// applyScaled is like apply() for quotient which single unit is unit / per
+// of 0.00000001.
+func (mode RoundingMode) applyScaled(
+	op string,
+	numerator, denominator *big.Int,
+	unit, per *big.Int,
+) *big.Int {
+	quotient := mode.divide(numerator, denominator)
+
+	hook, _ := inexactHook.Load().(func(string, Decimal))
+	if hook == nil {
+		return quotient
+	}
+
+	var lost big.Int
+	lost.Mul(quotient, denominator)
+	lost.Sub(&lost, numerator)
+	lost.Abs(&lost)
+
+	if lost.Sign() == 0 {
+		return quotient
+	}
+
+	lost.Mul(&lost, unit)
+
+	units, ok := fromBig(RoundUp.divide(&lost, new(big.Int).Mul(denominator, per)))
+	if !ok {
+		units = Decimal(Max - 1)
+	}
+
+	reportInexact(op, units)
+
+	return quotient
+}
@@ -0,0 +1,120 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type inexactRecord struct {
+	op   string
+	lost Decimal
+}
+
+func recordInexact(t *testing.T) *[]inexactRecord {
+	var records []inexactRecord
+
+	OnInexact(func(op string, lost Decimal) {
+		records = append(records, inexactRecord{op, lost})
+	})
+
+	t.Cleanup(func() { OnInexact(nil) })
+
+	return &records
+}
+
+func TestOnInexact_ReportsRoundingLoss(t *testing.T) {
+	test := assert.New(t)
+
+	records := recordInexact(t)
+
+	_, err := Must(FromString("1.235")).Round(2, RoundHalfUp)
+	test.NoError(err)
+
+	_, err = Must(FromString("1.23")).Round(2, RoundHalfUp)
+	test.NoError(err)
+
+	test.Equal([]inexactRecord{
+		{"round", Must(FromString("0.005"))},
+	}, *records)
+}
+
+func TestOnInexact_RoundsSubUnitLossUp(t *testing.T) {
+	test := assert.New(t)
+
+	records := recordInexact(t)
+
+	var basis CostBasis
+	test.NoError(basis.Buy(Must(FromString("3.0")), Must(FromString("1.0"))))
+	test.NoError(basis.Buy(Must(FromString("0.00000001")), Must(FromString("0.5"))))
+
+	_, err := basis.Sell(Must(FromString("1.0")), RoundDown)
+	test.NoError(err)
+
+	test.Equal([]inexactRecord{{"cost-basis.sell", 1}}, *records)
+}
+
+func TestOnInexact_ScalesLossOfMultiplication(t *testing.T) {
+	test := assert.New(t)
+
+	records := recordInexact(t)
+
+	_, err := WithMaxScale(0).Rounding(RoundDown).Multiply(
+		Must(FromString("1.5")),
+		Must(FromString("1.5")),
+	)
+	test.NoError(err)
+
+	test.Equal([]inexactRecord{
+		{"scale.multiply", Must(FromString("0.25"))},
+	}, *records)
+}
+
+func TestOnInexact_CanBeRemoved(t *testing.T) {
+	test := assert.New(t)
+
+	records := recordInexact(t)
+	OnInexact(nil)
+
+	_, err := Must(FromString("1.235")).Round(2, RoundHalfUp)
+	test.NoError(err)
+	test.Empty(*records)
+}
+
+func TestOnInexact_ReportsEveryRoundingOperation(t *testing.T) {
+	test := assert.New(t)
+
+	records := recordInexact(t)
+
+	var basis CostBasis
+	test.NoError(basis.Buy(Must(FromString("3.0")), Must(FromString("1.0"))))
+	test.NoError(basis.Buy(Must(FromString("0.00000001")), Must(FromString("0.5"))))
+
+	basis.Cost(RoundDown)
+	basis.AveragePrice(RoundDown)
+
+	_, _, err := Valuation(
+		map[string]Decimal{"BTC": Must(FromString("0.00000001"))},
+		map[string]Rate{
+			"BTC": {Base: "BTC", Quote: "USD", Price: Must(FromString("0.5"))},
+		},
+		"USD",
+	)
+	test.NoError(err)
+
+	_, err = mustBig("1").Quo(mustBig("3"), 2, RoundDown)
+	test.NoError(err)
+
+	mustBig("-1.235").Round(2, RoundHalfUp)
+	mustBig("1.235").Round(-1, RoundDown)
+
+	test.Equal([]inexactRecord{
+		{"cost-basis.cost", 1},
+		{"cost-basis.average", 1},
+		{"valuation.position", 1},
+		{"valuation", 1},
+		{"big.quo", Must(FromString("0.00333334"))},
+		{"big.round", Must(FromString("0.005"))},
+		{"big.round", Must(FromString("1.235"))},
+	}, *records)
+}
@@ -0,0 +1,96 @@
+// Package interest computes interest accrued on decimal balances over whole
+// periods, e.g. for staking and earn products. Rounding is applied at
+// documented steps with explicit mode, so balances are reproducible by
+// every service.
+package interest
+
+import (
+	"fmt"
+
+	"github.com/openware/decimal"
+)
+
+// Accrue returns balance of principal after given number of periods with
+// interest compounded every period. Balance is rounded to 8 places with
+// given mode after every period, as it would be if interest was credited
+// to account every period. Function will return error if number of periods
+// is negative or balance can't be stored in Decimal type.
+//
+// Example:
+//	interest.Accrue(principal, rate, 12, decimal.RoundDown) // 1126.82503010 for 1000 at 0.01
+func Accrue(
+	principal, ratePerPeriod decimal.Decimal,
+	periods int,
+	mode decimal.RoundingMode,
+) (decimal.Decimal, error) {
+	if periods < 0 {
+		return 0, fmt.Errorf("number of periods should not be negative: %d", periods)
+	}
+
+	balance := principal
+
+	for period := 0; period < periods; period++ {
+		var err error
+
+		balance, err = balance.FMA(ratePerPeriod, balance, mode)
+		if err != nil {
+			return 0, fmt.Errorf("period %d: %s", period+1, err)
+		}
+	}
+
+	return balance, nil
+}
+
+// CompoundInterest returns interest accrued on principal after given number
+// of periods, i.e. balance returned by Accrue() less principal.
+func CompoundInterest(
+	principal, ratePerPeriod decimal.Decimal,
+	periods int,
+	mode decimal.RoundingMode,
+) (decimal.Decimal, error) {
+	balance, err := Accrue(principal, ratePerPeriod, periods, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	return balance.SubSat(principal), nil
+}
+
+// SimpleInterest returns interest accrued on principal after given number
+// of periods without compounding. Interest is computed exactly and rounded
+// once to 8 places with given mode. Function will return error if number of
+// periods is negative or interest can't be stored in Decimal type.
+//
+// Example:
+//	interest.SimpleInterest(principal, rate, 12, decimal.RoundDown) // 120 for 1000 at 0.01
+func SimpleInterest(
+	principal, ratePerPeriod decimal.Decimal,
+	periods int,
+	mode decimal.RoundingMode,
+) (decimal.Decimal, error) {
+	if periods < 0 {
+		return 0, fmt.Errorf("number of periods should not be negative: %d", periods)
+	}
+
+	rate, err := ratePerPeriod.MulUint64(uint64(periods))
+	if err != nil {
+		return 0, err
+	}
+
+	return principal.MultiplyRound(rate, mode)
+}
+
+// AccrueSimple returns balance of principal after given number of periods
+// with simple interest, see SimpleInterest().
+func AccrueSimple(
+	principal, ratePerPeriod decimal.Decimal,
+	periods int,
+	mode decimal.RoundingMode,
+) (decimal.Decimal, error) {
+	accrued, err := SimpleInterest(principal, ratePerPeriod, periods, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	return principal.Add(accrued)
+}
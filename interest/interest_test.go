@@ -0,0 +1,85 @@
+package interest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openware/decimal"
+)
+
+func TestAccrue_CompoundsEveryPeriod(t *testing.T) {
+	test := assert.New(t)
+
+	principal := decimal.Must(decimal.FromString("1000.0"))
+	rate := decimal.Must(decimal.FromString("0.01"))
+
+	balance, err := Accrue(principal, rate, 12, decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("1126.82503010", balance.String())
+
+	balance, err = Accrue(principal, rate, 0, decimal.RoundDown)
+	test.NoError(err)
+	test.Equal(principal, balance)
+
+	accrued, err := CompoundInterest(principal, rate, 12, decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("126.82503010", accrued.String())
+}
+
+func TestAccrue_RoundsEveryPeriod(t *testing.T) {
+	test := assert.New(t)
+
+	// 0.00000001 at 50% is 0.000000015 after first period, so balance grows
+	// only if it's rounded up.
+	principal := decimal.Decimal(1)
+	rate := decimal.Must(decimal.FromString("0.5"))
+
+	balance, err := Accrue(principal, rate, 3, decimal.RoundDown)
+	test.NoError(err)
+	test.Equal(decimal.Decimal(1), balance)
+
+	balance, err = Accrue(principal, rate, 3, decimal.RoundUp)
+	test.NoError(err)
+	test.Equal(decimal.Decimal(5), balance)
+}
+
+func TestAccrue_Errors(t *testing.T) {
+	test := assert.New(t)
+
+	principal := decimal.Must(decimal.FromString("1000.0"))
+	rate := decimal.Must(decimal.FromString("1.0"))
+
+	_, err := Accrue(principal, rate, -1, decimal.RoundDown)
+	test.Error(err)
+
+	_, err = Accrue(principal, rate, 100, decimal.RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "period 27")
+}
+
+func TestSimpleInterest_RoundsOnce(t *testing.T) {
+	test := assert.New(t)
+
+	principal := decimal.Must(decimal.FromString("1000.0"))
+	rate := decimal.Must(decimal.FromString("0.01"))
+
+	accrued, err := SimpleInterest(principal, rate, 12, decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("120.00000000", accrued.String())
+
+	balance, err := AccrueSimple(principal, rate, 12, decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("1120.00000000", balance.String())
+
+	// 0.00000001 at 0.3 for 5 periods is 0.000000015.
+	accrued, err = SimpleInterest(decimal.Decimal(1), decimal.Must(decimal.FromString("0.3")), 5, decimal.RoundHalfEven)
+	test.NoError(err)
+	test.Equal(decimal.Decimal(2), accrued)
+
+	_, err = SimpleInterest(principal, rate, -1, decimal.RoundDown)
+	test.Error(err)
+
+	_, err = AccrueSimple(decimal.Must(decimal.FromString("99999999999.0")), rate, 1, decimal.RoundDown)
+	test.Error(err)
+}
@@ -0,0 +1,81 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Lerp returns a + (b - a) × t computed exactly and rounded once with given
+// mode. Values of t outside of [0, 1] extrapolate. Function will return
+// error if result is negative or can't be stored in Decimal type.
+//
+// Example:
+//	decimal.Lerp(a, b, decimal.Must(decimal.FromString("0.25")), decimal.RoundHalfEven)
+func Lerp(a, b, t Decimal, mode RoundingMode) (Decimal, error) {
+	var numerator big.Int
+	numerator.Sub(bigDecimal(b), bigDecimal(a))
+	numerator.Mul(&numerator, bigDecimal(t))
+	numerator.Add(&numerator, new(big.Int).Mul(bigDecimal(a), bigFractional))
+
+	return interpolated(&numerator, bigFractional, mode, func() string {
+		return fmt.Sprintf(
+			"%s + (%s - %s) × %s",
+			a.String(), b.String(), a.String(), t.String(),
+		)
+	})
+}
+
+// InterpolateAt returns value at x of line passing through (x0, y0) and
+// (x1, y1), computed exactly and rounded once with given mode. Values of x
+// outside of [x0, x1] extrapolate. Function will return error if x0 equals
+// x1 or result is negative or can't be stored in Decimal type.
+func InterpolateAt(x, x0, y0, x1, y1 Decimal, mode RoundingMode) (Decimal, error) {
+	if x0 == x1 {
+		return 0, fmt.Errorf(
+			"interpolation needs distinct points, x0 = x1 = %s", x0.String(),
+		)
+	}
+
+	var denominator big.Int
+	denominator.Sub(bigDecimal(x1), bigDecimal(x0))
+
+	var numerator big.Int
+	numerator.Sub(bigDecimal(y1), bigDecimal(y0))
+	numerator.Mul(&numerator, new(big.Int).Sub(bigDecimal(x), bigDecimal(x0)))
+	numerator.Add(&numerator, new(big.Int).Mul(bigDecimal(y0), &denominator))
+
+	if denominator.Sign() < 0 {
+		denominator.Neg(&denominator)
+		numerator.Neg(&numerator)
+	}
+
+	return interpolated(&numerator, &denominator, mode, func() string {
+		return fmt.Sprintf(
+			"(%s, %s)-(%s, %s) at %s",
+			x0.String(), y0.String(), x1.String(), y1.String(), x.String(),
+		)
+	})
+}
+
+func interpolated(
+	numerator, denominator *big.Int,
+	mode RoundingMode,
+	describe func() string,
+) (Decimal, error) {
+	if numerator.Sign() < 0 {
+		return 0, fmt.Errorf(
+			"decimal type can't hold negative result of interpolation: %s",
+			describe(),
+		)
+	}
+
+	result, ok := fromBig(mode.apply("interpolate", numerator, denominator, 1))
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold result of interpolation: %s",
+			describe(),
+		)
+	}
+
+	return result, nil
+}
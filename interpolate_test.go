@@ -0,0 +1,83 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLerp_InterpolatesBothDirections(t *testing.T) {
+	test := assert.New(t)
+
+	a := Must(FromString("100.0"))
+	b := Must(FromString("200.0"))
+	quarter := Must(FromString("0.25"))
+
+	actual, err := Lerp(a, b, quarter, RoundDown)
+	test.NoError(err)
+	test.Equal("125.00000000", actual.String())
+
+	actual, err = Lerp(b, a, quarter, RoundDown)
+	test.NoError(err)
+	test.Equal("175.00000000", actual.String())
+
+	actual, err = Lerp(a, b, Must(FromString("1.5")), RoundDown)
+	test.NoError(err)
+	test.Equal("250.00000000", actual.String())
+}
+
+func TestLerp_RoundsOnce(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Lerp(0, 1, Must(FromString("0.5")), RoundHalfEven)
+	test.NoError(err)
+	test.Equal(Decimal(0), actual)
+
+	actual, err = Lerp(0, 1, Must(FromString("0.5")), RoundHalfUp)
+	test.NoError(err)
+	test.Equal(Decimal(1), actual)
+}
+
+func TestLerp_ReturnsErrorOnNegativeResult(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Lerp(Must(FromString("2.0")), Must(FromString("1.0")), Must(FromString("3.0")), RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "negative")
+}
+
+func TestInterpolateAt_InterpolatesCurve(t *testing.T) {
+	test := assert.New(t)
+
+	// 30 days → 1.5%, 90 days → 2.5%
+	x0, y0 := Must(FromString("30.0")), Must(FromString("0.015"))
+	x1, y1 := Must(FromString("90.0")), Must(FromString("0.025"))
+
+	actual, err := InterpolateAt(Must(FromString("60.0")), x0, y0, x1, y1, RoundHalfEven)
+	test.NoError(err)
+	test.Equal("0.02000000", actual.String())
+
+	actual, err = InterpolateAt(Must(FromString("45.0")), x1, y1, x0, y0, RoundHalfEven)
+	test.NoError(err)
+	test.Equal("0.01750000", actual.String())
+
+	actual, err = InterpolateAt(Must(FromString("31.0")), x0, y0, x1, y1, RoundUp)
+	test.NoError(err)
+	test.Equal("0.01516667", actual.String())
+
+	actual, err = InterpolateAt(Must(FromString("0.0")), x0, y0, x1, y1, RoundDown)
+	test.NoError(err)
+	test.Equal("0.01000000", actual.String())
+}
+
+func TestInterpolateAt_ReturnsErrorOnInvalidInput(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := InterpolateAt(1, 2, 3, 2, 4, RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "distinct points")
+
+	_, err = InterpolateAt(0, Must(FromString("1.0")), Must(FromString("1.0")), Must(FromString("2.0")), Must(FromString("3.0")), RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "negative")
+}
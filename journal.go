@@ -0,0 +1,186 @@
+package decimal
+
+import (
+	"fmt"
+	"sync"
+)
+
+// JournalEntry is single application of amount to Journal balance. Op is
+// either OpAdd or OpSub.
+type JournalEntry struct {
+	ID     string  `json:"id"`
+	Op     string  `json:"op"`
+	Amount Decimal `json:"amount"`
+}
+
+// JournalSnapshot is state of Journal which can be persisted and restored
+// with RestoreJournal().
+type JournalSnapshot struct {
+	// Entries contains all applied entries in order of application.
+	Entries []JournalEntry `json:"entries"`
+
+	// Balance is sum of added amounts minus sum of subtracted amounts.
+	Balance Decimal `json:"balance"`
+
+	// Added and Subtracted are lifetime sums of amounts of OpAdd and OpSub
+	// entries. They aren't limited by range of Decimal type, since volume of
+	// long-running journal exceeds it while balance doesn't.
+	Added      BigDecimal `json:"added"`
+	Subtracted BigDecimal `json:"subtracted"`
+}
+
+// Journal applies identified additions and subtractions to exact running
+// balance exactly once, e.g. for settlement which may receive the same
+// mutation several times. Entries are kept to detect duplicates, so journal
+// grows with every applied entry.
+//
+// Journal is safe for concurrent use.
+//
+// Example:
+//	journal := decimal.NewJournal()
+//	journal.Apply("tx-1", decimal.OpAdd, amount) // true, nil
+//	journal.Apply("tx-1", decimal.OpAdd, amount) // false, nil
+type Journal struct {
+	mutex sync.Mutex
+
+	entries    []JournalEntry
+	index      map[string]int
+	balance    Decimal
+	added      Accumulator
+	subtracted Accumulator
+}
+
+// NewJournal returns empty Journal with zero balance.
+func NewJournal() *Journal {
+	return &Journal{index: make(map[string]int)}
+}
+
+// RestoreJournal returns Journal with entries of given snapshot. Entries are
+// replayed, so function will return error if they are invalid or totals of
+// snapshot don't match them.
+func RestoreJournal(snapshot JournalSnapshot) (*Journal, error) {
+	journal := NewJournal()
+
+	for _, entry := range snapshot.Entries {
+		applied, err := journal.Apply(entry.ID, entry.Op, entry.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if !applied {
+			return nil, fmt.Errorf(
+				"journal snapshot contains duplicate entry %q", entry.ID,
+			)
+		}
+	}
+
+	added, subtracted := journal.added.Big(), journal.subtracted.Big()
+
+	if journal.balance != snapshot.Balance ||
+		added.Cmp(snapshot.Added) != 0 ||
+		subtracted.Cmp(snapshot.Subtracted) != 0 {
+		return nil, fmt.Errorf(
+			"journal snapshot totals don't match entries: "+
+				"snapshot has balance %s, added %s, subtracted %s, "+
+				"entries give balance %s, added %s, subtracted %s",
+			snapshot.Balance.String(),
+			snapshot.Added.String(),
+			snapshot.Subtracted.String(),
+			journal.balance.String(),
+			added.String(),
+			subtracted.String(),
+		)
+	}
+
+	return journal, nil
+}
+
+// Apply adds amount to balance or subtracts it and reports whether entry was
+// applied. Entry with already applied ID is not applied again: method
+// returns false if it's identical to applied one and error otherwise.
+// Method will also return error on unknown operation or if balance would
+// become negative or can't be stored in Decimal type; journal is not changed
+// on error.
+func (journal *Journal) Apply(id, op string, amount Decimal) (bool, error) {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	entry := JournalEntry{ID: id, Op: op, Amount: amount}
+
+	if i, ok := journal.index[id]; ok {
+		if journal.entries[i] != entry {
+			return false, fmt.Errorf(
+				"journal entry %q was already applied as %s %s, "+
+					"can't apply as %s %s",
+				id,
+				journal.entries[i].Op,
+				journal.entries[i].Amount.String(),
+				op,
+				amount.String(),
+			)
+		}
+
+		return false, nil
+	}
+
+	balance, added, subtracted := journal.balance, journal.added, journal.subtracted
+
+	var err error
+	switch op {
+	case OpAdd:
+		balance, err = balance.Add(amount)
+		if err == nil {
+			err = added.Add(amount)
+		}
+
+	case OpSub:
+		balance, err = balance.Sub(amount)
+		if err == nil {
+			err = subtracted.Add(amount)
+		}
+
+	default:
+		err = fmt.Errorf("journal doesn't support operation %q", op)
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("journal entry %q can't be applied: %s", id, err)
+	}
+
+	journal.index[id] = len(journal.entries)
+	journal.entries = append(journal.entries, entry)
+	journal.balance, journal.added, journal.subtracted = balance, added, subtracted
+
+	return true, nil
+}
+
+// Applied reports whether entry with given ID was applied.
+func (journal *Journal) Applied(id string) bool {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	_, ok := journal.index[id]
+
+	return ok
+}
+
+// Balance returns current balance.
+func (journal *Journal) Balance() Decimal {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	return journal.balance
+}
+
+// Snapshot returns copy of current state of journal.
+func (journal *Journal) Snapshot() JournalSnapshot {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	return JournalSnapshot{
+		Entries:    append([]JournalEntry(nil), journal.entries...),
+		Balance:    journal.balance,
+		Added:      journal.added.Big(),
+		Subtracted: journal.subtracted.Big(),
+	}
+}
@@ -0,0 +1,138 @@
+package decimal
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournal_Apply_AppliesEntriesOnce(t *testing.T) {
+	test := assert.New(t)
+
+	journal := NewJournal()
+
+	applied, err := journal.Apply("a", OpAdd, Must(FromString("10.5")))
+	test.NoError(err)
+	test.True(applied)
+
+	applied, err = journal.Apply("b", OpSub, Must(FromString("0.5")))
+	test.NoError(err)
+	test.True(applied)
+
+	applied, err = journal.Apply("a", OpAdd, Must(FromString("10.5")))
+	test.NoError(err)
+	test.False(applied)
+
+	test.True(journal.Applied("b"))
+	test.False(journal.Applied("c"))
+	test.Equal("10.00000000", journal.Balance().String())
+}
+
+func TestJournal_Apply_ReturnsErrorOnConflictingDuplicate(t *testing.T) {
+	test := assert.New(t)
+
+	journal := NewJournal()
+
+	_, err := journal.Apply("a", OpAdd, Must(FromString("1.0")))
+	test.NoError(err)
+
+	_, err = journal.Apply("a", OpAdd, Must(FromString("2.0")))
+	test.Error(err)
+	test.Contains(err.Error(), "already applied as add 1.00000000")
+
+	test.Equal("1.00000000", journal.Balance().String())
+}
+
+func TestJournal_Apply_LeavesJournalUnchangedOnError(t *testing.T) {
+	test := assert.New(t)
+
+	journal := NewJournal()
+
+	_, err := journal.Apply("a", OpSub, Must(FromString("1.0")))
+	test.Error(err)
+	test.Contains(err.Error(), "negative result")
+
+	_, err = journal.Apply("b", OpMul, Must(FromString("1.0")))
+	test.Error(err)
+	test.Contains(err.Error(), "doesn't support operation")
+
+	test.False(journal.Applied("a"))
+	test.Empty(journal.Snapshot().Entries)
+}
+
+func TestRestoreJournal_ReplaysSnapshot(t *testing.T) {
+	test := assert.New(t)
+
+	journal := NewJournal()
+	_, err := journal.Apply("a", OpAdd, Must(FromString("3.0")))
+	test.NoError(err)
+	_, err = journal.Apply("b", OpSub, Must(FromString("1.0")))
+	test.NoError(err)
+
+	data, err := json.Marshal(journal.Snapshot())
+	test.NoError(err)
+
+	var snapshot JournalSnapshot
+	test.NoError(json.Unmarshal(data, &snapshot))
+
+	restored, err := RestoreJournal(snapshot)
+	test.NoError(err)
+	test.Equal(journal.Snapshot().Entries, restored.Snapshot().Entries)
+	test.Equal(journal.Balance(), restored.Balance())
+	test.Equal("3.00000000", restored.Snapshot().Added.String())
+	test.Equal("1.00000000", restored.Snapshot().Subtracted.String())
+
+	applied, err := restored.Apply("a", OpAdd, Must(FromString("3.0")))
+	test.NoError(err)
+	test.False(applied)
+}
+
+func TestRestoreJournal_ReturnsErrorOnInconsistentSnapshot(t *testing.T) {
+	test := assert.New(t)
+
+	snapshot := NewJournal().Snapshot()
+	snapshot.Entries = []JournalEntry{{ID: "a", Op: OpAdd, Amount: 1}}
+
+	_, err := RestoreJournal(snapshot)
+	test.Error(err)
+	test.Contains(err.Error(), "don't match")
+
+	snapshot.Entries = append(snapshot.Entries, snapshot.Entries[0])
+	_, err = RestoreJournal(snapshot)
+	test.Error(err)
+	test.Contains(err.Error(), "duplicate")
+}
+
+func TestJournal_Apply_TotalsExceedDecimalRange(t *testing.T) {
+	test := assert.New(t)
+
+	journal := NewJournal()
+	amount := Must(FromString("40000000000.0"))
+
+	for i := 0; i < 3; i++ {
+		applied, err := journal.Apply(fmt.Sprintf("deposit-%d", i), OpAdd, amount)
+		test.NoError(err)
+		test.True(applied)
+
+		applied, err = journal.Apply(fmt.Sprintf("withdrawal-%d", i), OpSub, amount)
+		test.NoError(err)
+		test.True(applied)
+	}
+
+	snapshot := journal.Snapshot()
+	test.Equal(Decimal(0), snapshot.Balance)
+	test.Equal("120000000000.00000000", snapshot.Added.String())
+	test.Equal("120000000000.00000000", snapshot.Subtracted.String())
+
+	data, err := json.Marshal(snapshot)
+	test.NoError(err)
+
+	var decoded JournalSnapshot
+	test.NoError(json.Unmarshal(data, &decoded))
+
+	restored, err := RestoreJournal(decoded)
+	test.NoError(err)
+	test.Equal("120000000000.00000000", restored.Snapshot().Added.String())
+}
@@ -0,0 +1,238 @@
+package decimal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JSONDifference describes value which differs between two JSON documents.
+type JSONDifference struct {
+	// Path is JSON Pointer (RFC 6901) to value, e.g. "/orders/0/price".
+	Path string
+
+	// A and B are JSON encodings of value in first and second document or
+	// nil if value is missing in that document.
+	A json.RawMessage
+	B json.RawMessage
+}
+
+// DiffJSON compares two JSON documents and returns differences between them
+// sorted by path. Values at given decimal paths are compared numerically,
+// so "1.5", 1.5 and "1.50000000" are equal, while all other values are
+// compared as decoded JSON.
+//
+// Decimal paths are JSON Pointers where "*" segment matches any object key
+// or array index. Decimal values may be encoded as JSON strings or numbers;
+// values which can't be parsed as Decimal are compared textually.
+//
+// Example:
+//	decimal.DiffJSON(a, b, "/balance", "/orders/*/price")
+func DiffJSON(a, b []byte, decimals ...string) ([]JSONDifference, error) {
+	left, err := decodeJSONDocument(a)
+	if err != nil {
+		return nil, fmt.Errorf("first JSON document can't be decoded: %s", err)
+	}
+
+	right, err := decodeJSONDocument(b)
+	if err != nil {
+		return nil, fmt.Errorf("second JSON document can't be decoded: %s", err)
+	}
+
+	patterns := make([][]string, len(decimals))
+	for i, path := range decimals {
+		patterns[i] = splitJSONPointer(path)
+	}
+
+	differ := jsonDiffer{patterns: patterns}
+	differ.diff(nil, left, right, true, true)
+
+	return differ.differences, nil
+}
+
+func decodeJSONDocument(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+type jsonDiffer struct {
+	patterns    [][]string
+	differences []JSONDifference
+}
+
+func (differ *jsonDiffer) diff(
+	path []string,
+	a, b interface{},
+	hasA, hasB bool,
+) {
+	if !hasA || !hasB {
+		differ.report(path, a, b, hasA, hasB)
+		return
+	}
+
+	if differ.isDecimal(path) {
+		if x, ok := jsonDecimal(a); ok {
+			if y, ok := jsonDecimal(b); ok {
+				if x != y {
+					differ.report(path, a, b, true, true)
+				}
+
+				return
+			}
+		}
+	}
+
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok {
+			differ.report(path, a, b, true, true)
+			return
+		}
+
+		keys := make([]string, 0, len(a)+len(b))
+		for key := range a {
+			keys = append(keys, key)
+		}
+		for key := range b {
+			if _, ok := a[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			x, hasX := a[key]
+			y, hasY := b[key]
+			differ.diff(append(path, key), x, y, hasX, hasY)
+		}
+
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok {
+			differ.report(path, a, b, true, true)
+			return
+		}
+
+		for i := 0; i < len(a) || i < len(b); i++ {
+			var x, y interface{}
+			if i < len(a) {
+				x = a[i]
+			}
+			if i < len(b) {
+				y = b[i]
+			}
+
+			differ.diff(append(path, strconv.Itoa(i)), x, y, i < len(a), i < len(b))
+		}
+
+	default:
+		if !reflect.DeepEqual(a, b) {
+			differ.report(path, a, b, true, true)
+		}
+	}
+}
+
+func (differ *jsonDiffer) isDecimal(path []string) bool {
+	for _, pattern := range differ.patterns {
+		if len(pattern) != len(path) {
+			continue
+		}
+
+		matches := true
+		for i, segment := range pattern {
+			if segment != "*" && segment != path[i] {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (differ *jsonDiffer) report(
+	path []string,
+	a, b interface{},
+	hasA, hasB bool,
+) {
+	difference := JSONDifference{Path: joinJSONPointer(path)}
+
+	// Values were decoded from JSON, so they can always be encoded back.
+	if hasA {
+		difference.A, _ = json.Marshal(a)
+	}
+	if hasB {
+		difference.B, _ = json.Marshal(b)
+	}
+
+	differ.differences = append(differ.differences, difference)
+}
+
+// jsonDecimal returns Decimal encoded as JSON string or number. Integers
+// without decimal point are accepted as well.
+func jsonDecimal(value interface{}) (Decimal, bool) {
+	var text string
+
+	switch value := value.(type) {
+	case string:
+		text = value
+	case json.Number:
+		text = value.String()
+	default:
+		return 0, false
+	}
+
+	if !strings.Contains(text, ".") {
+		text += ".0"
+	}
+
+	var decimal Decimal
+	if err := decimal.Scan(text); err != nil {
+		return 0, false
+	}
+
+	return decimal, true
+}
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+func splitJSONPointer(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = jsonPointerUnescaper.Replace(segment)
+	}
+
+	return segments
+}
+
+func joinJSONPointer(path []string) string {
+	var builder strings.Builder
+	for _, segment := range path {
+		builder.WriteByte('/')
+		builder.WriteString(jsonPointerEscaper.Replace(segment))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,58 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffJSON_ComparesDecimalsNumerically(t *testing.T) {
+	test := assert.New(t)
+
+	differences, err := DiffJSON(
+		[]byte(`{"balance": "1.5", "orders": [{"price": 2, "id": "a"}]}`),
+		[]byte(`{"balance": 1.50000000, "orders": [{"price": "2.0", "id": "a"}]}`),
+		"/balance", "/orders/*/price",
+	)
+	test.NoError(err)
+	test.Empty(differences)
+}
+
+func TestDiffJSON_ComparesOtherFieldsAsDecoded(t *testing.T) {
+	test := assert.New(t)
+
+	differences, err := DiffJSON(
+		[]byte(`{"balance": "1.5", "fee": "0.1", "tags": ["x"]}`),
+		[]byte(`{"balance": "1.6", "fee": "0.10", "tags": ["x", "y"], "new": true}`),
+		"/balance",
+	)
+	test.NoError(err)
+	test.Equal([]JSONDifference{
+		{Path: "/balance", A: []byte(`"1.5"`), B: []byte(`"1.6"`)},
+		{Path: "/fee", A: []byte(`"0.1"`), B: []byte(`"0.10"`)},
+		{Path: "/new", B: []byte(`true`)},
+		{Path: "/tags/1", B: []byte(`"y"`)},
+	}, differences)
+}
+
+func TestDiffJSON_ComparesUnparsableDecimalsTextually(t *testing.T) {
+	test := assert.New(t)
+
+	differences, err := DiffJSON(
+		[]byte(`{"a/b": {"price": "n/a"}}`),
+		[]byte(`{"a/b": {"price": 1e3}}`),
+		"/a~1b/price",
+	)
+	test.NoError(err)
+	test.Equal([]JSONDifference{
+		{Path: "/a~1b/price", A: []byte(`"n/a"`), B: []byte(`1e3`)},
+	}, differences)
+}
+
+func TestDiffJSON_ReturnsErrorOnInvalidDocument(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := DiffJSON([]byte(`{}`), []byte(`{`))
+	test.Error(err)
+	test.Contains(err.Error(), "second JSON document")
+}
@@ -0,0 +1,82 @@
+package decimal
+
+import (
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// latencyHook holds function registered by OnLatency().
+var latencyHook atomic.Value
+
+// OnLatency registers function which is called with time spent in every
+// parse ("parse"), format ("format") and multiplication ("mul") operation
+// and with first caller outside of this package, e.g. to attribute decimal
+// overhead to endpoints. Passing nil removes registered function.
+//
+// Instrumentation is compiled in only when building with decimallatency
+// tag, otherwise registered function is never called and operations have
+// no overhead:
+//	go test -tags decimallatency ./...
+//
+// Function is called synchronously from goroutine performing operation, so
+// it should be fast and safe for concurrent use. Timing and caller lookup
+// are much slower than operations themselves, so instrumentation is meant
+// for performance investigations only.
+func OnLatency(hook func(op string, caller runtime.Frame, elapsed time.Duration)) {
+	latencyHook.Store(hook)
+}
+
+// latency returns function registered by OnLatency() if instrumentation is
+// compiled in.
+func latency() func(string, runtime.Frame, time.Duration) {
+	if !latencyEnabled {
+		return nil
+	}
+
+	hook, _ := latencyHook.Load().(func(string, runtime.Frame, time.Duration))
+
+	return hook
+}
+
+// observeLatency calls hook with time elapsed since start. It should be
+// deferred by instrumented operation.
+func observeLatency(
+	hook func(string, runtime.Frame, time.Duration),
+	op string,
+	start time.Time,
+) {
+	elapsed := time.Since(start)
+
+	hook(op, caller(), elapsed)
+}
+
+// caller returns first frame of call stack outside of this package. Tests
+// of this package are considered outside of it.
+func caller() runtime.Frame {
+	var pcs [32]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs[:])])
+
+	for {
+		frame, more := frames.Next()
+		if !inPackage(frame) || !more {
+			return frame
+		}
+	}
+}
+
+// packagePrefix is prefix of names of functions of this package.
+var packagePrefix = func() string {
+	pc, _, _, _ := runtime.Caller(0)
+	name := runtime.FuncForPC(pc).Name()
+
+	slash := strings.LastIndexByte(name, '/')
+
+	return name[:slash+1+strings.IndexByte(name[slash+1:], '.')+1]
+}()
+
+func inPackage(frame runtime.Frame) bool {
+	return strings.HasPrefix(frame.Function, packagePrefix) &&
+		!strings.HasSuffix(frame.File, "_test.go")
+}
@@ -0,0 +1,7 @@
+//go:build decimallatency
+
+package decimal
+
+// latencyEnabled reports whether instrumentation of OnLatency() is compiled
+// in.
+const latencyEnabled = true
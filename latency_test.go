@@ -0,0 +1,56 @@
+//go:build decimallatency
+
+package decimal
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnLatency_ReportsOperationsWithCaller(t *testing.T) {
+	test := assert.New(t)
+
+	var ops, callers []string
+	OnLatency(func(op string, caller runtime.Frame, elapsed time.Duration) {
+		ops = append(ops, op)
+		callers = append(callers, caller.Function)
+
+		test.True(elapsed >= 0)
+	})
+	defer OnLatency(nil)
+
+	value, err := FromString("1.5")
+	test.NoError(err)
+
+	_, err = value.Multiply(value)
+	test.NoError(err)
+
+	_ = value.String()
+
+	test.Equal([]string{"parse", "mul", "format"}, ops)
+	for _, caller := range callers {
+		test.True(
+			strings.HasSuffix(caller, ".TestOnLatency_ReportsOperationsWithCaller"),
+			caller,
+		)
+	}
+}
+
+func TestOnLatency_IgnoresNestedOperations(t *testing.T) {
+	test := assert.New(t)
+
+	var ops []string
+	OnLatency(func(op string, caller runtime.Frame, elapsed time.Duration) {
+		ops = append(ops, op)
+	})
+	defer OnLatency(nil)
+
+	var value Decimal
+	test.NoError(value.Scan([]byte("1.5")))
+
+	test.Equal([]string{"parse"}, ops)
+}
@@ -0,0 +1,90 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// LegacyConversion describes conversion of float64 by FromFloatLegacyAudit().
+type LegacyConversion struct {
+	// Value is converted value.
+	Value Decimal
+
+	// Exact is exact binary value of float64.
+	Exact *big.Rat
+
+	// Delta is Value minus Exact, e.g. positive if conversion rounded up.
+	Delta *big.Rat
+}
+
+// FromFloatLegacy returns given float64 converted the same way as legacy
+// Ruby code did, to make migration of float fields reproducible: shortest
+// decimal representation of value (Float#to_s) is rounded half up to 8
+// places. It panics if value is not finite, negative or too big to be
+// stored in Decimal type; use FromFloatLegacyAudit() to handle such values.
+//
+// Example:
+//	decimal.FromFloatLegacy(0.123456785) // will return 0.12345679
+func FromFloatLegacy(value float64) Decimal {
+	conversion, err := FromFloatLegacyAudit(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return conversion.Value
+}
+
+// FromFloatLegacyAudit converts value like FromFloatLegacy() does and
+// reports difference between result and exact binary value of float64, e.g.
+// to audit migration: 0.123456785 is stored as binary value slightly below
+// it, so exact conversion would round it down, while legacy conversion
+// rounds it up. Function will return error if value can't be stored in
+// Decimal type.
+func FromFloatLegacyAudit(value float64) (LegacyConversion, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
+		return LegacyConversion{}, fmt.Errorf(
+			"decimal type can't hold float value: %v", value,
+		)
+	}
+
+	text := strconv.FormatFloat(math.Abs(value), 'f', -1, 64)
+
+	integer, fraction := text, ""
+	if period := strings.IndexByte(text, '.'); period >= 0 {
+		integer, fraction = text[:period], text[period+1:]
+	}
+
+	up := len(fraction) > MaxPointsFractional &&
+		fraction[MaxPointsFractional] >= '5'
+	if len(fraction) > MaxPointsFractional {
+		fraction = fraction[:MaxPointsFractional]
+	}
+	fraction += strings.Repeat("0", MaxPointsFractional-len(fraction))
+
+	var units big.Int
+	units.SetString(integer+fraction, 10)
+	if up {
+		units.Add(&units, big.NewInt(1))
+	}
+
+	result, ok := fromBig(&units)
+	if !ok {
+		return LegacyConversion{}, fmt.Errorf(
+			"decimal type can't hold float value: %v", value,
+		)
+	}
+
+	exact := new(big.Rat).SetFloat64(math.Abs(value))
+
+	delta := new(big.Rat).SetFrac(&units, bigFractional)
+	delta.Sub(delta, exact)
+
+	return LegacyConversion{
+		Value: result,
+		Exact: exact,
+		Delta: delta,
+	}, nil
+}
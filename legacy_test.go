@@ -0,0 +1,57 @@
+package decimal
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromFloatLegacy_RoundsShortestRepresentationHalfUp(t *testing.T) {
+	test := assert.New(t)
+
+	for value, expected := range map[float64]string{
+		0:                  "0.00000000",
+		1.5:                "1.50000000",
+		0.1:                "0.10000000",
+		0.123456785:        "0.12345679",
+		0.123456784999:     "0.12345678",
+		1e-9:               "0.00000000",
+		5e-9:               "0.00000001",
+		99999999999.99998:  "99999999999.99998000",
+		12345678.123456789: "12345678.12345679",
+	} {
+		test.Equal(expected, FromFloatLegacy(value).String(), "%v", value)
+	}
+
+	test.Equal(Decimal(0), FromFloatLegacy(math.Copysign(0, -1)))
+}
+
+func TestFromFloatLegacy_PanicsOnInvalidValue(t *testing.T) {
+	test := assert.New(t)
+
+	for _, value := range []float64{-1, math.NaN(), math.Inf(1), 1e11} {
+		test.Panics(func() { FromFloatLegacy(value) }, "%v", value)
+	}
+}
+
+func TestFromFloatLegacyAudit_ReportsDeltaFromExactValue(t *testing.T) {
+	test := assert.New(t)
+
+	conversion, err := FromFloatLegacyAudit(0.123456785)
+	test.NoError(err)
+	test.Equal("0.12345679", conversion.Value.String())
+
+	// Binary value is below 0.123456785, so exact conversion would round
+	// down, while legacy conversion rounds up by more than half of unit.
+	test.Equal(-1, conversion.Exact.Cmp(big.NewRat(123456785, 1000000000)))
+	test.Equal(1, conversion.Delta.Cmp(big.NewRat(1, 200000000)))
+
+	conversion, err = FromFloatLegacyAudit(0.5)
+	test.NoError(err)
+	test.Equal(0, conversion.Delta.Sign())
+
+	_, err = FromFloatLegacyAudit(-0.5)
+	test.Error(err)
+}
@@ -0,0 +1,168 @@
+package decimal
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+)
+
+// PriceLevels is sparse vector of quantities by price level, where levels
+// are multiples of tick size. Quantities are updated exactly and levels are
+// iterated in price order.
+//
+// Example:
+//	levels, _ := decimal.NewPriceLevels(decimal.Must(decimal.FromString("0.5")))
+//	levels.Add(decimal.Must(decimal.FromString("100.5")), quantity)
+//	for price, quantity := range levels.Ascending() {
+//		...
+//	}
+type PriceLevels struct {
+	tick    Decimal
+	indexes []uint64
+	levels  map[uint64]Decimal
+}
+
+// NewPriceLevels returns empty PriceLevels with given tick size or error if
+// tick size is zero.
+func NewPriceLevels(tick Decimal) (*PriceLevels, error) {
+	if tick == 0 {
+		return nil, fmt.Errorf("price levels tick size should be positive")
+	}
+
+	return &PriceLevels{
+		tick:   tick,
+		levels: map[uint64]Decimal{},
+	}, nil
+}
+
+// Tick returns tick size.
+func (levels *PriceLevels) Tick() Decimal {
+	return levels.tick
+}
+
+// Add adds quantity to level of given price. Method will return error if
+// price is not multiple of tick size or resulting quantity can't be stored
+// in Decimal type.
+func (levels *PriceLevels) Add(price, quantity Decimal) error {
+	index, err := levels.index(price)
+	if err != nil {
+		return err
+	}
+
+	total, ok := add(levels.levels[index], quantity)
+	if !ok {
+		return fmt.Errorf(
+			"decimal type can't hold quantity of price level %s: %s + %s",
+			price.String(),
+			levels.levels[index].String(),
+			quantity.String(),
+		)
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	if _, ok := levels.levels[index]; !ok {
+		position := sort.Search(len(levels.indexes), func(i int) bool {
+			return levels.indexes[i] >= index
+		})
+
+		levels.indexes = append(levels.indexes, 0)
+		copy(levels.indexes[position+1:], levels.indexes[position:])
+		levels.indexes[position] = index
+	}
+
+	levels.levels[index] = total
+
+	return nil
+}
+
+// Remove subtracts quantity from level of given price and drops level when
+// its quantity reaches zero. Method will return error if price is not
+// multiple of tick size or level holds less than given quantity.
+func (levels *PriceLevels) Remove(price, quantity Decimal) error {
+	index, err := levels.index(price)
+	if err != nil {
+		return err
+	}
+
+	current := levels.levels[index]
+	if quantity > current {
+		return fmt.Errorf(
+			"price level %s can't remove %s, quantity: %s",
+			price.String(),
+			quantity.String(),
+			current.String(),
+		)
+	}
+
+	if quantity < current {
+		levels.levels[index] = current - quantity
+		return nil
+	}
+
+	if _, ok := levels.levels[index]; !ok {
+		return nil
+	}
+
+	delete(levels.levels, index)
+
+	position := sort.Search(len(levels.indexes), func(i int) bool {
+		return levels.indexes[i] >= index
+	})
+	levels.indexes = append(levels.indexes[:position], levels.indexes[position+1:]...)
+
+	return nil
+}
+
+// Quantity returns quantity at given price, zero for empty levels.
+func (levels *PriceLevels) Quantity(price Decimal) Decimal {
+	if price%levels.tick != 0 {
+		return 0
+	}
+
+	return levels.levels[uint64(price/levels.tick)]
+}
+
+// Len returns number of non-empty levels.
+func (levels *PriceLevels) Len() int {
+	return len(levels.indexes)
+}
+
+// Ascending iterates over non-empty levels from lowest price to highest.
+// Levels must not be modified during iteration.
+func (levels *PriceLevels) Ascending() iter.Seq2[Decimal, Decimal] {
+	return func(yield func(Decimal, Decimal) bool) {
+		for _, index := range levels.indexes {
+			if !yield(Decimal(index)*levels.tick, levels.levels[index]) {
+				return
+			}
+		}
+	}
+}
+
+// Descending iterates over non-empty levels from highest price to lowest.
+// Levels must not be modified during iteration.
+func (levels *PriceLevels) Descending() iter.Seq2[Decimal, Decimal] {
+	return func(yield func(Decimal, Decimal) bool) {
+		for i := len(levels.indexes) - 1; i >= 0; i-- {
+			index := levels.indexes[i]
+			if !yield(Decimal(index)*levels.tick, levels.levels[index]) {
+				return
+			}
+		}
+	}
+}
+
+func (levels *PriceLevels) index(price Decimal) (uint64, error) {
+	if price%levels.tick != 0 {
+		return 0, fmt.Errorf(
+			"price %s is not multiple of tick size %s",
+			price.String(),
+			levels.tick.String(),
+		)
+	}
+
+	return uint64(price / levels.tick), nil
+}
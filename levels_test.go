@@ -0,0 +1,76 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceLevels_IteratesInPriceOrder(t *testing.T) {
+	test := assert.New(t)
+
+	levels, err := NewPriceLevels(Must(FromString("0.5")))
+	test.NoError(err)
+
+	test.NoError(levels.Add(Must(FromString("101.0")), Must(FromString("1.0"))))
+	test.NoError(levels.Add(Must(FromString("100.5")), Must(FromString("2.0"))))
+	test.NoError(levels.Add(Must(FromString("102.0")), Must(FromString("3.0"))))
+	test.NoError(levels.Add(Must(FromString("100.5")), Must(FromString("0.25"))))
+
+	var prices, quantities []string
+	for price, quantity := range levels.Ascending() {
+		prices = append(prices, price.String())
+		quantities = append(quantities, quantity.String())
+	}
+
+	test.Equal([]string{"100.50000000", "101.00000000", "102.00000000"}, prices)
+	test.Equal([]string{"2.25000000", "1.00000000", "3.00000000"}, quantities)
+
+	prices = nil
+	for price := range levels.Descending() {
+		prices = append(prices, price.String())
+		break
+	}
+
+	test.Equal([]string{"102.00000000"}, prices)
+}
+
+func TestPriceLevels_Remove_DropsEmptyLevels(t *testing.T) {
+	test := assert.New(t)
+
+	levels, err := NewPriceLevels(Must(FromString("0.5")))
+	test.NoError(err)
+
+	price := Must(FromString("100.5"))
+
+	test.NoError(levels.Add(price, Must(FromString("2.0"))))
+	test.NoError(levels.Remove(price, Must(FromString("0.5"))))
+	test.Equal("1.50000000", levels.Quantity(price).String())
+
+	err = levels.Remove(price, Must(FromString("2.0")))
+	test.Error(err)
+	test.Contains(err.Error(), "can't remove")
+
+	test.NoError(levels.Remove(price, Must(FromString("1.5"))))
+	test.Equal(0, levels.Len())
+	test.Equal(Decimal(0), levels.Quantity(price))
+
+	test.NoError(levels.Remove(price, 0))
+	test.NoError(levels.Add(price, 0))
+	test.Equal(0, levels.Len())
+}
+
+func TestPriceLevels_ReturnsErrorOffTick(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := NewPriceLevels(0)
+	test.Error(err)
+
+	levels, err := NewPriceLevels(Must(FromString("0.5")))
+	test.NoError(err)
+
+	err = levels.Add(Must(FromString("100.25")), 1)
+	test.Error(err)
+	test.Contains(err.Error(), "not multiple of tick size")
+	test.Equal(Decimal(0), levels.Quantity(Must(FromString("100.25"))))
+}
@@ -0,0 +1,89 @@
+package decimal
+
+import (
+	"fmt"
+	"math/bits"
+	"time"
+)
+
+// LimitWindow is duration for which withdrawal limits are defined.
+const LimitWindow = 24 * time.Hour
+
+// LimitSchedule maps KYC levels to amounts which can be withdrawn during
+// LimitWindow.
+//
+// Example:
+//	schedule := decimal.LimitSchedule{
+//		1: decimal.Must(decimal.FromString("0.5")),
+//		2: decimal.Must(decimal.FromString("10.0")),
+//	}
+//	limit, _ := schedule.Limit(2)
+//	limit.Remaining(decimal.Must(decimal.FromString("2.5"))) // 7.50000000
+type LimitSchedule map[int]Decimal
+
+// Limit returns limit configured for given level or error if level is not
+// present in schedule.
+func (schedule LimitSchedule) Limit(level int) (Limit, error) {
+	amount, ok := schedule[level]
+	if !ok {
+		return Limit{}, fmt.Errorf(
+			"limit schedule doesn't contain KYC level %d", level,
+		)
+	}
+
+	return Limit{Level: level, Amount: amount}, nil
+}
+
+// Limit is amount which can be withdrawn during LimitWindow on given KYC
+// level.
+type Limit struct {
+	Level  int
+	Amount Decimal
+}
+
+// Remaining returns amount which can still be withdrawn after given amount
+// was spent in current window, or zero if limit is exceeded.
+func (limit Limit) Remaining(spent Decimal) Decimal {
+	if spent >= limit.Amount {
+		return 0
+	}
+
+	return limit.Amount - spent
+}
+
+// Prorate returns part of limit proportional to given part of LimitWindow,
+// rounded down to 0.00000001. It's used when limit applies only to part of
+// window, e.g. after KYC level change.
+//
+// Example:
+//	limit.Amount // 10.00000000
+//	limit.Prorate(6 * time.Hour) // 2.50000000
+func (limit Limit) Prorate(elapsed time.Duration) Decimal {
+	if elapsed <= 0 {
+		return 0
+	}
+
+	if elapsed >= LimitWindow {
+		return limit.Amount
+	}
+
+	hi, lo := bits.Mul64(limit.Amount.Uint64(), uint64(elapsed))
+	amount, remainder := bits.Div64(hi, lo, uint64(LimitWindow))
+	if remainder != 0 {
+		reportInexact("limit.prorate", 1)
+	}
+
+	return Decimal(amount)
+}
+
+// RemainingProrated returns amount which can still be withdrawn when only
+// given part of LimitWindow is covered by limit.
+func (limit Limit) RemainingProrated(
+	spent Decimal,
+	elapsed time.Duration,
+) Decimal {
+	return Limit{
+		Level:  limit.Level,
+		Amount: limit.Prorate(elapsed),
+	}.Remaining(spent)
+}
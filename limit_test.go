@@ -0,0 +1,54 @@
+package decimal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitSchedule_Limit_ReturnsErrorOnUnknownLevel(t *testing.T) {
+	test := assert.New(t)
+
+	schedule := LimitSchedule{1: Must(FromString("0.5"))}
+
+	limit, err := schedule.Limit(1)
+	test.NoError(err)
+	test.Equal(Limit{Level: 1, Amount: Must(FromString("0.5"))}, limit)
+
+	_, err = schedule.Limit(3)
+	test.Error(err)
+	test.Contains(err.Error(), "KYC level 3")
+}
+
+func TestLimit_Remaining_StopsAtZero(t *testing.T) {
+	test := assert.New(t)
+
+	limit := Limit{Amount: Must(FromString("10.0"))}
+
+	test.Equal("7.50000000", limit.Remaining(Must(FromString("2.5"))).String())
+	test.Equal("0.00000000", limit.Remaining(Must(FromString("10.0"))).String())
+	test.Equal("0.00000000", limit.Remaining(Must(FromString("12.0"))).String())
+}
+
+func TestLimit_Prorate_RoundsDown(t *testing.T) {
+	test := assert.New(t)
+
+	limit := Limit{Amount: Must(FromString("10.0"))}
+
+	test.Equal("2.50000000", limit.Prorate(6*time.Hour).String())
+	test.Equal("3.33333333", limit.Prorate(8*time.Hour).String())
+	test.Equal("10.00000000", limit.Prorate(48*time.Hour).String())
+	test.Equal("0.00000000", limit.Prorate(-time.Hour).String())
+}
+
+func TestLimit_RemainingProrated_AppliesProration(t *testing.T) {
+	test := assert.New(t)
+
+	limit := Limit{Amount: Must(FromString("10.0"))}
+
+	test.Equal(
+		"1.50000000",
+		limit.RemainingProrated(Must(FromString("1.0")), 6*time.Hour).String(),
+	)
+}
@@ -0,0 +1,202 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// LotMethod defines order in which lots are consumed by Lots.Sell().
+type LotMethod int
+
+const (
+	// FIFO consumes oldest lots first.
+	FIFO LotMethod = iota
+
+	// LIFO consumes newest lots first.
+	LIFO
+
+	// HIFO consumes lots with highest price first, oldest first among lots
+	// with equal price.
+	HIFO
+)
+
+// String returns name of lot method.
+func (method LotMethod) String() string {
+	switch method {
+	case FIFO:
+		return "FIFO"
+	case LIFO:
+		return "LIFO"
+	case HIFO:
+		return "HIFO"
+	default:
+		return fmt.Sprintf("LotMethod(%d)", int(method))
+	}
+}
+
+// Lot is quantity purchased at single price.
+type Lot struct {
+	Quantity Decimal
+	Price    Decimal
+}
+
+// Realization describes result of Lots.Sell().
+type Realization struct {
+	// Consumed lists (parts of) lots consumed by sale in order of
+	// consumption.
+	Consumed []Lot
+
+	// Proceeds is quantity × sale price and Cost is sum of quantity × price
+	// of consumed lots, both rounded with requested mode.
+	Proceeds Decimal
+	Cost     Decimal
+
+	// Gain is difference between exact proceeds and exact cost, which is
+	// negative if cost exceeds proceeds. Requested mode is applied to its
+	// magnitude.
+	Gain Signed
+}
+
+// Lots tracks open purchase lots and consumes them by exact quantities when
+// selling, according to LotMethod.
+type Lots struct {
+	method   LotMethod
+	quantity Decimal
+	open     []Lot
+}
+
+// NewLots returns empty Lots consumed by given method.
+func NewLots(method LotMethod) *Lots {
+	return &Lots{method: method}
+}
+
+// Buy adds lot of given quantity purchased at given price. Method will
+// return error if total held quantity can't be stored in Decimal type.
+func (lots *Lots) Buy(quantity, price Decimal) error {
+	total, ok := add(lots.quantity, quantity)
+	if !ok {
+		return fmt.Errorf(
+			"decimal type can't hold quantity of lots: %s + %s",
+			lots.quantity.String(),
+			quantity.String(),
+		)
+	}
+
+	if quantity == 0 {
+		return nil
+	}
+
+	lots.quantity = total
+	lots.open = append(lots.open, Lot{Quantity: quantity, Price: price})
+
+	return nil
+}
+
+// Sell consumes given quantity from open lots and returns realized
+// proceeds, cost and gain of sale at given price, rounded with given mode.
+// Method will return error if quantity exceeds held one or amounts can't be
+// stored in Decimal type; lots are not changed on error.
+func (lots *Lots) Sell(
+	quantity, price Decimal,
+	mode RoundingMode,
+) (Realization, error) {
+	if quantity > lots.quantity {
+		return Realization{}, fmt.Errorf(
+			"lots can't sell %s, held quantity: %s",
+			quantity.String(),
+			lots.quantity.String(),
+		)
+	}
+
+	var (
+		realization Realization
+		open        = append([]Lot(nil), lots.open...)
+		left        = quantity
+		cost        big.Int
+		proceeds    big.Int
+	)
+
+	for left > 0 {
+		index := lots.next(open)
+
+		consumed := open[index]
+		if consumed.Quantity > left {
+			consumed.Quantity = left
+		}
+
+		open[index].Quantity -= consumed.Quantity
+		if open[index].Quantity == 0 {
+			open = append(open[:index], open[index+1:]...)
+		}
+
+		left -= consumed.Quantity
+
+		realization.Consumed = append(realization.Consumed, consumed)
+
+		cost.Add(&cost, product(consumed.Quantity, consumed.Price))
+	}
+
+	proceeds.Set(product(quantity, price))
+
+	var ok [3]bool
+
+	realization.Proceeds, ok[0] = fromBig(
+		mode.apply("lots.sell", &proceeds, bigFractional, 1),
+	)
+	realization.Cost, ok[1] = fromBig(
+		mode.apply("lots.sell", &cost, bigFractional, 1),
+	)
+
+	var gain big.Int
+	gain.Sub(&proceeds, &cost)
+	loss := gain.Sign() < 0
+	gain.Abs(&gain)
+
+	var magnitude Decimal
+	magnitude, ok[2] = fromBig(mode.apply("lots.sell", &gain, bigFractional, 1))
+	realization.Gain = NewSigned(magnitude, loss)
+
+	if !ok[0] || !ok[1] || !ok[2] {
+		return Realization{}, fmt.Errorf(
+			"decimal type can't hold realization of sale: %s × %s",
+			quantity.String(),
+			price.String(),
+		)
+	}
+
+	lots.open = open
+	lots.quantity -= quantity
+
+	return realization, nil
+}
+
+// Quantity returns total quantity of open lots.
+func (lots *Lots) Quantity() Decimal {
+	return lots.quantity
+}
+
+// Open returns copy of open lots in order of purchase.
+func (lots *Lots) Open() []Lot {
+	return append([]Lot(nil), lots.open...)
+}
+
+// next returns index of lot which should be consumed next.
+func (lots *Lots) next(open []Lot) int {
+	switch lots.method {
+	case LIFO:
+		return len(open) - 1
+
+	case HIFO:
+		highest := 0
+		for index, lot := range open {
+			if lot.Price > open[highest].Price {
+				highest = index
+			}
+		}
+
+		return highest
+
+	default:
+		return 0
+	}
+}
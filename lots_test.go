@@ -0,0 +1,98 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLots(method LotMethod) *Lots {
+	lots := NewLots(method)
+	lots.Buy(Must(FromString("1.0")), Must(FromString("100.0")))
+	lots.Buy(Must(FromString("1.0")), Must(FromString("300.0")))
+	lots.Buy(Must(FromString("1.0")), Must(FromString("200.0")))
+	return lots
+}
+
+func TestLots_Sell_ConsumesLotsInMethodOrder(t *testing.T) {
+	test := assert.New(t)
+
+	for method, expected := range map[LotMethod][]Lot{
+		FIFO: {
+			{Must(FromString("1.0")), Must(FromString("100.0"))},
+			{Must(FromString("0.5")), Must(FromString("300.0"))},
+		},
+		LIFO: {
+			{Must(FromString("1.0")), Must(FromString("200.0"))},
+			{Must(FromString("0.5")), Must(FromString("300.0"))},
+		},
+		HIFO: {
+			{Must(FromString("1.0")), Must(FromString("300.0"))},
+			{Must(FromString("0.5")), Must(FromString("200.0"))},
+		},
+	} {
+		lots := newTestLots(method)
+
+		realization, err := lots.Sell(
+			Must(FromString("1.5")),
+			Must(FromString("250.0")),
+			RoundDown,
+		)
+		test.NoError(err, method.String())
+		test.Equal(expected, realization.Consumed, method.String())
+		test.Equal("1.50000000", lots.Quantity().String(), method.String())
+	}
+}
+
+func TestLots_Sell_RealizesGainAndLoss(t *testing.T) {
+	test := assert.New(t)
+
+	realization, err := newTestLots(FIFO).Sell(
+		Must(FromString("1.5")),
+		Must(FromString("250.0")),
+		RoundDown,
+	)
+	test.NoError(err)
+	test.Equal("375.00000000", realization.Proceeds.String())
+	test.Equal("250.00000000", realization.Cost.String())
+	test.Equal("125.00000000", realization.Gain.String())
+	test.Equal(1, realization.Gain.Sign())
+
+	realization, err = newTestLots(HIFO).Sell(
+		Must(FromString("1.5")),
+		Must(FromString("250.0")),
+		RoundDown,
+	)
+	test.NoError(err)
+	test.Equal("400.00000000", realization.Cost.String())
+	test.Equal("-25.00000000", realization.Gain.String())
+	test.True(realization.Gain.Negative())
+}
+
+func TestLots_Sell_RoundsExactGain(t *testing.T) {
+	test := assert.New(t)
+
+	lots := NewLots(FIFO)
+	test.NoError(lots.Buy(Must(FromString("0.00000001")), Must(FromString("0.5"))))
+
+	realization, err := lots.Sell(
+		Must(FromString("0.00000001")),
+		Must(FromString("1.5")),
+		RoundHalfEven,
+	)
+	test.NoError(err)
+	test.Equal("0.00000002", realization.Proceeds.String())
+	test.Equal("0.00000000", realization.Cost.String())
+	test.Equal("0.00000001", realization.Gain.String())
+}
+
+func TestLots_Sell_ReturnsErrorOnExcessQuantity(t *testing.T) {
+	test := assert.New(t)
+
+	lots := newTestLots(FIFO)
+
+	_, err := lots.Sell(Must(FromString("3.1")), 0, RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "can't sell")
+	test.Len(lots.Open(), 3)
+}
@@ -0,0 +1,105 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Pow returns current value raised to given non-negative power, e.g.
+// compounding factor (1 + r)^n. Zero power of any value is 1. Method will
+// return error if result can't be stored in Decimal type without loosing
+// precision, i.e. value with d digits after decimal point can be raised to
+// at most 8/d power.
+//
+// Example:
+//	decimal.Scan("1.05")
+//	decimal.Pow(4) // will return 1.21550625
+func (decimal Decimal) Pow(n int) (Decimal, error) {
+	if n < 0 {
+		return 0, fmt.Errorf(
+			"decimal type can't be raised to negative power: %s^%d",
+			decimal.String(),
+			n,
+		)
+	}
+
+	one := Decimal(MaxFractional)
+
+	switch {
+	case n == 0:
+		return one, nil
+	case n == 1 || decimal == 0 || decimal == one:
+		return decimal, nil
+	}
+
+	// Value with d places raised to n power has exactly d × n places, since
+	// its digits without trailing zeros are never multiple of 10.
+	places := decimal.Places()
+	if places > 0 && n > MaxPointsFractional/places {
+		return 0, fmt.Errorf(
+			"decimal type can't hold fractional part of power: %s^%d",
+			decimal.String(),
+			n,
+		)
+	}
+
+	// Integer part of any value other than 0 and 1 overflows after 64
+	// multiplications, so result is never computed for large powers.
+	if n > 64 {
+		return 0, decimal.overflowPow(n)
+	}
+
+	var power, divisor big.Int
+	power.Exp(bigDecimal(decimal), big.NewInt(int64(n)), nil)
+	divisor.Exp(bigFractional, big.NewInt(int64(n-1)), nil)
+	power.Quo(&power, &divisor)
+
+	result, ok := fromBig(&power)
+	if !ok {
+		return 0, decimal.overflowPow(n)
+	}
+
+	return result, nil
+}
+
+func (decimal Decimal) overflowPow(n int) error {
+	return fmt.Errorf(
+		"decimal type can't hold integer part of power: %s^%d",
+		decimal.String(),
+		n,
+	)
+}
+
+// Sqrt returns square root of current value rounded with given mode, e.g.
+// for geometric mean of prices. Square root of value which can be stored in
+// Decimal type always fits into it, so returned error is always nil and
+// exists for consistency with other rounding operations.
+//
+// Example:
+//	decimal.Scan("2.0")
+//	decimal.Sqrt(decimal.RoundHalfEven) // will return 1.41421356
+func (decimal Decimal) Sqrt(mode RoundingMode) (Decimal, error) {
+	// √(u / 1e8) × 1e8 = √(u × 1e8)
+	var square, root, remainder big.Int
+	square.Mul(bigDecimal(decimal), bigFractional)
+	root.Sqrt(&square)
+	remainder.Sub(&square, new(big.Int).Mul(&root, &root))
+
+	result := root.Uint64()
+	if remainder.Sign() != 0 {
+		// Exact root is never equal to root + 0.5, and it's above it only
+		// if square > root² + root.
+		half := -1
+		if remainder.Uint64() > result {
+			half = 1
+		}
+
+		if mode.increment(half, result%2 == 1, true) {
+			result++
+		}
+
+		reportInexact("sqrt", 1)
+	}
+
+	return Decimal(result), nil
+}
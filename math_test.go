@@ -0,0 +1,100 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_Pow_ComputesExactPower(t *testing.T) {
+	test := assert.New(t)
+
+	for _, example := range []struct {
+		value    string
+		n        int
+		expected string
+	}{
+		{"1.05", 4, "1.21550625"},
+		{"2.0", 10, "1024.00000000"},
+		{"0.1", 8, "0.00000001"},
+		{"0.5", 3, "0.12500000"},
+		{"7.5", 0, "1.00000000"},
+		{"7.5", 1, "7.50000000"},
+		{"0.0", 1000, "0.00000000"},
+		{"1.0", 1000, "1.00000000"},
+		{"0.00000001", 1, "0.00000001"},
+	} {
+		actual, err := Must(FromString(example.value)).Pow(example.n)
+		test.NoError(err, "%s^%d", example.value, example.n)
+		test.Equal(example.expected, actual.String(), "%s^%d", example.value, example.n)
+	}
+}
+
+func TestDecimal_Pow_ReturnsErrorWhenResultTooPrecise(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Must(FromString("1.05")).Pow(5)
+	test.Error(err)
+	test.Contains(err.Error(), "fractional part of power")
+
+	_, err = Must(FromString("0.1")).Pow(9)
+	test.Error(err)
+}
+
+func TestDecimal_Pow_ReturnsErrorWhenResultTooBig(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Must(FromString("10.0")).Pow(11)
+	test.Error(err)
+	test.Contains(err.Error(), "integer part of power")
+
+	_, err = Must(FromString("2.0")).Pow(1000)
+	test.Error(err)
+
+	_, err = Must(FromString("2.0")).Pow(-1)
+	test.Error(err)
+	test.Contains(err.Error(), "negative power")
+}
+
+func TestDecimal_Sqrt_RoundsWithGivenMode(t *testing.T) {
+	test := assert.New(t)
+
+	for _, example := range []struct {
+		value    string
+		mode     RoundingMode
+		expected string
+	}{
+		{"2.0", RoundDown, "1.41421356"},
+		{"2.0", RoundUp, "1.41421357"},
+		{"3.0", RoundHalfEven, "1.73205081"},
+		{"3.0", RoundDown, "1.73205080"},
+		{"0.25", RoundDown, "0.50000000"},
+		{"0.0", RoundUp, "0.00000000"},
+		{"0.00000001", RoundDown, "0.00010000"},
+		{"99999999999.99999999", RoundDown, "316227.76601683"},
+		{"99999999999.99999999", RoundUp, "316227.76601684"},
+	} {
+		actual, err := Must(FromString(example.value)).Sqrt(example.mode)
+		test.NoError(err)
+		test.Equal(example.expected, actual.String(), "√%s (%s)", example.value, example.mode)
+	}
+}
+
+func TestDecimal_Sqrt_ReportsInexactResult(t *testing.T) {
+	test := assert.New(t)
+
+	var ops []string
+	OnInexact(func(op string, lost Decimal) {
+		test.Equal(Decimal(1), lost)
+		ops = append(ops, op)
+	})
+	defer OnInexact(nil)
+
+	_, err := Must(FromString("2.25")).Sqrt(RoundHalfEven)
+	test.NoError(err)
+
+	_, err = Must(FromString("2.0")).Sqrt(RoundHalfEven)
+	test.NoError(err)
+
+	test.Equal([]string{"sqrt"}, ops)
+}
@@ -0,0 +1,75 @@
+package decimal
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Mean returns arithmetic average of given values. Values are summed
+// exactly and only final division is rounded with given mode. Function will
+// return error if there are no values.
+func Mean(values []Decimal, mode RoundingMode) (Decimal, error) {
+	if len(values) == 0 {
+		return 0, fmt.Errorf("mean of no values is undefined")
+	}
+
+	hi, lo := sum128(values)
+
+	// Mean never exceeds greatest value, so quotient always fits into
+	// uint64 and hi is less than count.
+	count := uint64(len(values))
+	quotient, remainder := bits.Div64(hi, lo, count)
+
+	if remainder != 0 {
+		half := compare(remainder, count-remainder)
+		if mode.increment(half, quotient%2 == 1, true) {
+			quotient++
+		}
+
+		reportInexact("mean", 1)
+	}
+
+	return Decimal(quotient), nil
+}
+
+// WeightedMean returns average of given values weighted by given weights,
+// e.g. average price of fills weighted by their quantities. Products and
+// their sums are accumulated exactly in 128-bit integers with carry, and
+// only final division is rounded with given mode. Function will return
+// error if number of values and weights differs or sum of weights is zero.
+func WeightedMean(values, weights []Decimal, mode RoundingMode) (Decimal, error) {
+	if len(values) != len(weights) {
+		return 0, fmt.Errorf(
+			"weighted mean needs weight for every value: %d values, %d weights",
+			len(values),
+			len(weights),
+		)
+	}
+
+	// Sum of products is 192-bit top:hi:lo, sum of weights is 128-bit.
+	var top, hi, lo, weightHi, weightLo, carry uint64
+	for i, value := range values {
+		productHi, productLo := bits.Mul64(value.Uint64(), weights[i].Uint64())
+
+		lo, carry = bits.Add64(lo, productLo, 0)
+		hi, carry = bits.Add64(hi, productHi, carry)
+		top += carry
+
+		weightHi, weightLo, _ = add128(weightHi, weightLo, 0, weights[i].Uint64())
+	}
+
+	if weightHi == 0 && weightLo == 0 {
+		return 0, fmt.Errorf("weighted mean is undefined for zero total weight")
+	}
+
+	// Units cancel out: Σ(v × w) / Σw, where result never exceeds greatest
+	// value.
+	quotient := mode.apply(
+		"mean.weighted",
+		bigWords(top, hi, lo),
+		bigWords(weightHi, weightLo),
+		1,
+	)
+
+	return Decimal(quotient.Uint64()), nil
+}
@@ -0,0 +1,110 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMean_RoundsFinalDivision(t *testing.T) {
+	test := assert.New(t)
+
+	values := []Decimal{
+		Must(FromString("1.0")),
+		Must(FromString("1.0")),
+		Must(FromString("2.0")),
+	}
+
+	actual, err := Mean(values, RoundDown)
+	test.NoError(err)
+	test.Equal("1.33333333", actual.String())
+
+	actual, err = Mean(values[1:], RoundDown)
+	test.NoError(err)
+	test.Equal("1.50000000", actual.String())
+
+	actual, err = Mean([]Decimal{0, 1}, RoundHalfEven)
+	test.NoError(err)
+	test.Equal(Decimal(0), actual)
+
+	actual, err = Mean([]Decimal{0, 1}, RoundHalfUp)
+	test.NoError(err)
+	test.Equal(Decimal(1), actual)
+}
+
+func TestMean_DoesNotOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("99999999999.99999999"))
+
+	actual, err := Mean([]Decimal{max, max, max}, RoundUp)
+	test.NoError(err)
+	test.Equal(max, actual)
+
+	actual, err = Mean([]Decimal{max, max - 1}, RoundUp)
+	test.NoError(err)
+	test.Equal(max, actual)
+}
+
+func TestMean_ReturnsErrorOnNoValues(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Mean(nil, RoundDown)
+	test.Error(err)
+}
+
+func TestWeightedMean_RoundsFinalDivision(t *testing.T) {
+	test := assert.New(t)
+
+	prices := []Decimal{
+		Must(FromString("100.0")),
+		Must(FromString("101.0")),
+		Must(FromString("103.0")),
+	}
+	quantities := []Decimal{
+		Must(FromString("1.0")),
+		Must(FromString("1.0")),
+		Must(FromString("1.0")),
+	}
+
+	actual, err := WeightedMean(prices, quantities, RoundDown)
+	test.NoError(err)
+	test.Equal("101.33333333", actual.String())
+
+	actual, err = WeightedMean(prices, quantities, RoundUp)
+	test.NoError(err)
+	test.Equal("101.33333334", actual.String())
+
+	quantities[2] = 0
+	actual, err = WeightedMean(prices, quantities, RoundDown)
+	test.NoError(err)
+	test.Equal("100.50000000", actual.String())
+}
+
+func TestWeightedMean_DoesNotOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("99999999999.99999999"))
+
+	values := []Decimal{max, max, max, 1}
+	weights := []Decimal{max, max, max, 0}
+
+	actual, err := WeightedMean(values, weights, RoundUp)
+	test.NoError(err)
+	test.Equal(max, actual)
+}
+
+func TestWeightedMean_ReturnsErrorOnInvalidWeights(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := WeightedMean([]Decimal{1}, nil, RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "1 values, 0 weights")
+
+	_, err = WeightedMean([]Decimal{1}, []Decimal{0}, RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "zero total weight")
+
+	_, err = WeightedMean(nil, nil, RoundDown)
+	test.Error(err)
+}
@@ -0,0 +1,181 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// Money is amount in given currency. Arithmetic of Money refuses to combine
+// amounts in different currencies.
+//
+// Money is encoded in JSON as object with "amount" and "currency" fields
+// and in SQL as text with amount and code separated by space, e.g.
+// "12.50 USD".
+type Money struct {
+	Amount   Decimal `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+// NewMoney returns Money with given amount in currency with given code,
+// which is converted to upper case.
+//
+// Example:
+//	decimal.NewMoney(decimal.Lit(12_50000000), "usd").String() // will return "12.50 USD"
+func NewMoney(amount Decimal, currency string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(currency)}
+}
+
+// ParseMoney returns Money parsed from its text representation, see
+// Money.String().
+func ParseMoney(value string) (Money, error) {
+	var money Money
+	err := money.Scan(value)
+	return money, err
+}
+
+// Places returns number of digits after decimal point of currency, see
+// MinorUnits(), or 8 if currency is not in table.
+func (money Money) Places() int {
+	if places, ok := MinorUnits(money.Currency); ok {
+		return places
+	}
+
+	return MaxPointsFractional
+}
+
+// String returns amount with number of places of currency followed by
+// currency code. Amounts with more places are formatted with all of them,
+// so representation is never lossy.
+func (money Money) String() string {
+	places := money.Places()
+	if money.Amount.Places() > places {
+		places = money.Amount.Places()
+	}
+
+	return NumberFormatter{Places: places}.Format(money.Amount) + " " + money.Currency
+}
+
+// same returns error if other Money is in different currency.
+func (money Money) same(other Money, op string) error {
+	if !strings.EqualFold(money.Currency, other.Currency) {
+		return fmt.Errorf(
+			"money in %s can't be %s money in %s",
+			money.Currency,
+			op,
+			other.Currency,
+		)
+	}
+
+	return nil
+}
+
+// Add returns sum of current and given money. Method will return error if
+// currencies differ or sum can't be stored in Decimal type.
+func (money Money) Add(addend Money) (Money, error) {
+	if err := money.same(addend, "added to"); err != nil {
+		return Money{}, err
+	}
+
+	amount, err := money.Amount.Add(addend.Amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: amount, Currency: money.Currency}, nil
+}
+
+// Sub returns current money less given one. Method will return error if
+// currencies differ or subtrahend is greater than current amount.
+func (money Money) Sub(subtrahend Money) (Money, error) {
+	if err := money.same(subtrahend, "subtracted from"); err != nil {
+		return Money{}, err
+	}
+
+	amount, err := money.Amount.Sub(subtrahend.Amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: amount, Currency: money.Currency}, nil
+}
+
+// Cmp returns -1, 0 or 1 if current amount is less than, equal to or
+// greater than other one. Method will return error if currencies differ.
+func (money Money) Cmp(other Money) (int, error) {
+	if err := money.same(other, "compared with"); err != nil {
+		return 0, err
+	}
+
+	return compare(money.Amount.Uint64(), other.Amount.Uint64()), nil
+}
+
+// Multiply returns amount multiplied by given factor and rounded once to
+// places of currency with given mode, e.g. price of quantity. Method will
+// return error if result can't be stored in Decimal type.
+func (money Money) Multiply(factor Decimal, mode RoundingMode) (Money, error) {
+	amount, err := WithMaxScale(money.Places()).
+		Rounding(mode).
+		Multiply(money.Amount, factor)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: amount, Currency: money.Currency}, nil
+}
+
+// Round returns money with amount rounded to places of currency with given
+// mode.
+func (money Money) Round(mode RoundingMode) (Money, error) {
+	amount, err := money.Amount.Round(money.Places(), mode)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: amount, Currency: money.Currency}, nil
+}
+
+// Scan parses value from given string/bytes representation, see
+// Money.String(), and return error if it's malformed.
+// Used in SQL communication.
+func (money *Money) Scan(data interface{}) error {
+	switch data := data.(type) {
+	case []byte:
+		return money.Scan(string(data))
+
+	case string:
+		fields := strings.Fields(data)
+		if len(fields) != 2 {
+			return fmt.Errorf(
+				"money should be amount followed by currency code: %q", data,
+			)
+		}
+
+		// Amounts of currencies without minor units have no decimal point.
+		text := fields[0]
+		if !strings.Contains(text, ".") {
+			text += ".0"
+		}
+
+		amount, err := FromString(text)
+		if err != nil {
+			return err
+		}
+
+		*money = NewMoney(amount, fields[1])
+
+		return nil
+
+	default:
+		return fmt.Errorf(
+			"money type expected to be []byte, but %T received",
+			data,
+		)
+	}
+}
+
+// Value returns string representation of Money type.
+// Used in SQL communication.
+func (money Money) Value() (driver.Value, error) {
+	return money.String(), nil
+}
@@ -0,0 +1,108 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney_String_UsesCurrencyPlaces(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("12.50 USD", NewMoney(Must(FromString("12.5")), "usd").String())
+	test.Equal("1200 JPY", NewMoney(Must(FromString("1200.0")), "JPY").String())
+	test.Equal("1.234 KWD", NewMoney(Must(FromString("1.234")), "KWD").String())
+	test.Equal("12.345 USD", NewMoney(Must(FromString("12.345")), "USD").String())
+	test.Equal("1.00000000 XYZ", NewMoney(Must(FromString("1.0")), "XYZ").String())
+}
+
+func TestParseMoney_ReadsString(t *testing.T) {
+	test := assert.New(t)
+
+	for _, value := range []Money{
+		NewMoney(Must(FromString("12.5")), "USD"),
+		NewMoney(Must(FromString("1200.0")), "JPY"),
+		NewMoney(Must(FromString("12.345")), "USD"),
+		NewMoney(0, "BTC"),
+	} {
+		actual, err := ParseMoney(value.String())
+		test.NoError(err, value.String())
+		test.Equal(value, actual)
+	}
+
+	for _, value := range []string{"", "12.50", "USD", "12.50 USD EUR", "abc USD"} {
+		_, err := ParseMoney(value)
+		test.Error(err, value)
+	}
+}
+
+func TestMoney_RefusesToMixCurrencies(t *testing.T) {
+	test := assert.New(t)
+
+	usd := NewMoney(Must(FromString("10.0")), "USD")
+	eur := NewMoney(Must(FromString("10.0")), "EUR")
+
+	_, err := usd.Add(eur)
+	test.Error(err)
+	test.Contains(err.Error(), "USD can't be added to money in EUR")
+
+	_, err = usd.Sub(eur)
+	test.Error(err)
+
+	_, err = usd.Cmp(eur)
+	test.Error(err)
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	test := assert.New(t)
+
+	a := NewMoney(Must(FromString("10.25")), "USD")
+	b := NewMoney(Must(FromString("3.0")), "usd")
+
+	sum, err := a.Add(b)
+	test.NoError(err)
+	test.Equal(NewMoney(Must(FromString("13.25")), "USD"), sum)
+
+	difference, err := a.Sub(b)
+	test.NoError(err)
+	test.Equal(NewMoney(Must(FromString("7.25")), "USD"), difference)
+
+	_, err = b.Sub(a)
+	test.Error(err)
+
+	order, err := a.Cmp(b)
+	test.NoError(err)
+	test.Equal(1, order)
+
+	product, err := a.Multiply(Must(FromString("0.333")), RoundHalfEven)
+	test.NoError(err)
+	test.Equal("3.41 USD", product.String())
+
+	rounded, err := NewMoney(Must(FromString("1200.5")), "JPY").Round(RoundHalfUp)
+	test.NoError(err)
+	test.Equal("1201 JPY", rounded.String())
+}
+
+func TestMoney_SQLAndJSON(t *testing.T) {
+	test := assert.New(t)
+
+	money := NewMoney(Must(FromString("12.5")), "USD")
+
+	value, err := money.Value()
+	test.NoError(err)
+	test.Equal("12.50 USD", value)
+
+	var scanned Money
+	test.NoError(scanned.Scan([]byte("12.50 USD")))
+	test.Equal(money, scanned)
+	test.Error(scanned.Scan(42))
+
+	data, err := json.Marshal(money)
+	test.NoError(err)
+	test.Equal(`{"amount":"12.50000000","currency":"USD"}`, string(data))
+
+	var decoded Money
+	test.NoError(json.Unmarshal(data, &decoded))
+	test.Equal(money, decoded)
+}
@@ -0,0 +1,72 @@
+package decimal
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// NullDecimal represents Decimal which may be null, e.g. value of nullable
+// DECIMAL(19, 8) column. It's null unless Valid is true, like
+// sql.NullInt64.
+//
+// Null is encoded as JSON null and SQL NULL.
+type NullDecimal struct {
+	Decimal
+	Valid bool
+}
+
+// Scan parses value from given string/bytes representation like
+// Decimal.Scan() does. Nil value is scanned as null, as well as value which
+// can't be parsed.
+// Used in SQL communication.
+func (null *NullDecimal) Scan(data interface{}) error {
+	*null = NullDecimal{}
+
+	if data == nil {
+		return nil
+	}
+
+	if err := null.Decimal.Scan(data); err != nil {
+		return err
+	}
+
+	null.Valid = true
+
+	return nil
+}
+
+// Value returns string representation of Decimal or nil if it's null.
+// Used in SQL communication.
+func (null NullDecimal) Value() (driver.Value, error) {
+	if !null.Valid {
+		return nil, nil
+	}
+
+	return null.Decimal.Value()
+}
+
+// MarshalJSON returns JSON string with Decimal representation or null.
+func (null NullDecimal) MarshalJSON() ([]byte, error) {
+	if !null.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(null.Decimal)
+}
+
+// UnmarshalJSON reads Decimal from JSON string. JSON null is read as null.
+func (null *NullDecimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*null = NullDecimal{}
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &null.Decimal); err != nil {
+		return err
+	}
+
+	null.Valid = true
+
+	return nil
+}
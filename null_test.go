@@ -0,0 +1,63 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullDecimal_Scan(t *testing.T) {
+	test := assert.New(t)
+
+	var null NullDecimal
+	test.NoError(null.Scan([]byte("1.50000000")))
+	test.Equal(NullDecimal{Decimal: Must(FromString("1.5")), Valid: true}, null)
+
+	test.NoError(null.Scan(nil))
+	test.Equal(NullDecimal{}, null)
+
+	test.NoError(null.Scan("2.0"))
+	test.Error(null.Scan("garbage"))
+	test.Equal(NullDecimal{}, null)
+}
+
+func TestNullDecimal_Value(t *testing.T) {
+	test := assert.New(t)
+
+	value, err := NullDecimal{}.Value()
+	test.NoError(err)
+	test.Nil(value)
+
+	value, err = NullDecimal{Decimal: Must(FromString("1.5")), Valid: true}.Value()
+	test.NoError(err)
+	test.Equal("1.50000000", value)
+}
+
+func TestNullDecimal_JSON(t *testing.T) {
+	test := assert.New(t)
+
+	type row struct {
+		Price NullDecimal `json:"price"`
+		Fee   NullDecimal `json:"fee"`
+	}
+
+	data, err := json.Marshal(row{
+		Price: NullDecimal{Decimal: Must(FromString("1.5")), Valid: true},
+	})
+	test.NoError(err)
+	test.Equal(`{"price":"1.50000000","fee":null}`, string(data))
+
+	var decoded row
+	test.NoError(json.Unmarshal(data, &decoded))
+	test.Equal(Must(FromString("1.5")), decoded.Price.Decimal)
+	test.True(decoded.Price.Valid)
+	test.False(decoded.Fee.Valid)
+
+	decoded.Price.Valid = true
+	test.NoError(json.Unmarshal([]byte(`{"price":null}`), &decoded))
+	test.Equal(NullDecimal{}, decoded.Price)
+
+	test.Error(json.Unmarshal([]byte(`{"price":"1"}`), &decoded))
+	test.Error(json.Unmarshal([]byte(`{"price":1.5}`), &decoded))
+}
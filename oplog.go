@@ -0,0 +1,339 @@
+package decimal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Operations supported by Operation.
+const (
+	// OpAdd sums operands exactly.
+	OpAdd = "add"
+
+	// OpSub subtracts all following operands from first one exactly.
+	OpSub = "sub"
+
+	// OpMul multiplies two operands exactly, see Decimal.Multiply().
+	OpMul = "mul"
+
+	// OpMulRound multiplies two operands and rounds product to Places with
+	// Rounding, see Scale.Multiply().
+	OpMulRound = "mulround"
+
+	// OpRound rounds single operand to Places with Rounding, see
+	// Decimal.Round().
+	OpRound = "round"
+)
+
+// Operation is single journaled decimal computation, which can be replayed
+// and verified deterministically.
+//
+// Operations are encoded as text, one per line:
+//	<op> <rounding> <places> <result> <operand>...
+//
+// Example:
+//	mulround half-even 2 2.02000000 1.99999999 1.01000000
+type Operation struct {
+	Op       string
+	Operands []Decimal
+	Rounding RoundingMode
+	Places   int
+	Result   Decimal
+}
+
+// NewOperation computes given operation and returns it with result filled
+// in. Rounding and places are used by OpMulRound and OpRound only.
+func NewOperation(
+	op string,
+	rounding RoundingMode,
+	places int,
+	operands ...Decimal,
+) (Operation, error) {
+	operation := Operation{
+		Op:       op,
+		Operands: operands,
+		Rounding: rounding,
+		Places:   places,
+	}
+
+	result, err := operation.Compute()
+	if err != nil {
+		return Operation{}, err
+	}
+
+	operation.Result = result
+
+	return operation, nil
+}
+
+// Compute returns result of operation computed from its operands.
+func (operation Operation) Compute() (Decimal, error) {
+	return operation.compute(true)
+}
+
+// compute is like Compute() but reports discarded part of value to
+// OnInexact() hook only if report is true.
+func (operation Operation) compute(report bool) (Decimal, error) {
+	operands := operation.Operands
+
+	arity := func(count int) error {
+		if len(operands) != count {
+			return fmt.Errorf(
+				"operation %s expects %d operands, but %d received",
+				operation.Op,
+				count,
+				len(operands),
+			)
+		}
+
+		return nil
+	}
+
+	switch operation.Op {
+	case OpAdd:
+		var total Decimal
+
+		for _, operand := range operands {
+			var ok bool
+
+			total, ok = add(total, operand)
+			if !ok {
+				return 0, fmt.Errorf(
+					"decimal type can't hold result of operation %s",
+					operation.Op,
+				)
+			}
+		}
+
+		return total, nil
+
+	case OpSub:
+		if len(operands) == 0 {
+			return 0, arity(1)
+		}
+
+		total := operands[0]
+
+		for _, operand := range operands[1:] {
+			if operand > total {
+				return 0, fmt.Errorf(
+					"decimal type can't hold negative result of operation %s",
+					operation.Op,
+				)
+			}
+
+			total -= operand
+		}
+
+		return total, nil
+
+	case OpMul:
+		if err := arity(2); err != nil {
+			return 0, err
+		}
+
+		return operands[0].Multiply(operands[1])
+
+	case OpMulRound:
+		if err := arity(2); err != nil {
+			return 0, err
+		}
+
+		if operation.Places < 0 || operation.Places > MaxPointsFractional {
+			return 0, fmt.Errorf(
+				"number of places should be from 0 to %d: %d",
+				MaxPointsFractional,
+				operation.Places,
+			)
+		}
+
+		return WithMaxScale(operation.Places).
+			Rounding(operation.Rounding).
+			multiply(operands[0], operands[1], report)
+
+	case OpRound:
+		if err := arity(1); err != nil {
+			return 0, err
+		}
+
+		if report {
+			return operands[0].Round(operation.Places, operation.Rounding)
+		}
+
+		return operands[0].round(operation.Places, operation.Rounding)
+
+	default:
+		return 0, fmt.Errorf("unknown operation: %q", operation.Op)
+	}
+}
+
+// Verify recomputes operation and returns error if result differs from
+// recorded one. Rounding loss was reported when operation was computed, so
+// replay doesn't report it to OnInexact() hook again.
+func (operation Operation) Verify() error {
+	result, err := operation.compute(false)
+	if err != nil {
+		return err
+	}
+
+	if result != operation.Result {
+		return fmt.Errorf(
+			"operation %s replayed to %s, but %s was recorded",
+			operation.Op,
+			result.String(),
+			operation.Result.String(),
+		)
+	}
+
+	return nil
+}
+
+// String returns text encoding of operation.
+func (operation Operation) String() string {
+	fields := []string{
+		operation.Op,
+		operation.Rounding.String(),
+		strconv.Itoa(operation.Places),
+		operation.Result.String(),
+	}
+
+	for _, operand := range operation.Operands {
+		fields = append(fields, operand.String())
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// ParseOperation returns Operation decoded from its text encoding.
+func ParseOperation(line string) (Operation, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 {
+		return Operation{}, fmt.Errorf(
+			"operation log entry is too short: %q", line,
+		)
+	}
+
+	operation := Operation{Op: fields[0]}
+
+	rounding, ok := roundingModes[fields[1]]
+	if !ok {
+		return Operation{}, fmt.Errorf(
+			"operation log entry has unknown rounding mode: %q", line,
+		)
+	}
+
+	operation.Rounding = rounding
+
+	places, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return Operation{}, fmt.Errorf(
+			"operation log entry has invalid places: %q", line,
+		)
+	}
+
+	operation.Places = places
+
+	if operation.Result, err = FromString(fields[3]); err != nil {
+		return Operation{}, err
+	}
+
+	for _, field := range fields[4:] {
+		operand, err := FromString(field)
+		if err != nil {
+			return Operation{}, err
+		}
+
+		operation.Operands = append(operation.Operands, operand)
+	}
+
+	return operation, nil
+}
+
+// OperationWriter journals operations to underlying writer.
+type OperationWriter struct {
+	writer *bufio.Writer
+}
+
+// NewOperationWriter returns OperationWriter writing to given writer.
+func NewOperationWriter(writer io.Writer) *OperationWriter {
+	return &OperationWriter{writer: bufio.NewWriter(writer)}
+}
+
+// Write journals given operation.
+func (writer *OperationWriter) Write(operation Operation) error {
+	_, err := writer.writer.WriteString(operation.String() + "\n")
+	return err
+}
+
+// Flush writes buffered operations to underlying writer.
+func (writer *OperationWriter) Flush() error {
+	return writer.writer.Flush()
+}
+
+// OperationReader reads operations journaled by OperationWriter.
+type OperationReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// NewOperationReader returns OperationReader reading from given reader.
+func NewOperationReader(reader io.Reader) *OperationReader {
+	return &OperationReader{scanner: bufio.NewScanner(reader)}
+}
+
+// Read returns next operation or io.EOF if there are no more operations.
+func (reader *OperationReader) Read() (Operation, error) {
+	for reader.scanner.Scan() {
+		reader.line++
+
+		line := reader.scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		operation, err := ParseOperation(line)
+		if err != nil {
+			return Operation{}, fmt.Errorf("line %d: %s", reader.line, err)
+		}
+
+		return operation, nil
+	}
+
+	if err := reader.scanner.Err(); err != nil {
+		return Operation{}, err
+	}
+
+	return Operation{}, io.EOF
+}
+
+// VerifyOperations replays all operations from given reader and returns
+// first error encountered, with line number of failed operation.
+func VerifyOperations(reader io.Reader) error {
+	operations := NewOperationReader(reader)
+
+	for {
+		operation, err := operations.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := operation.Verify(); err != nil {
+			return fmt.Errorf("line %d: %s", operations.line, err)
+		}
+	}
+}
+
+var roundingModes = map[string]RoundingMode{
+	RoundDown.String():     RoundDown,
+	RoundUp.String():       RoundUp,
+	RoundHalfUp.String():   RoundHalfUp,
+	RoundHalfDown.String(): RoundHalfDown,
+	RoundHalfEven.String(): RoundHalfEven,
+}
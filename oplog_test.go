@@ -0,0 +1,146 @@
+package decimal
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOperation_ComputesResult(t *testing.T) {
+	test := assert.New(t)
+
+	for _, example := range []struct {
+		op       string
+		rounding RoundingMode
+		places   int
+		operands []string
+		expected string
+	}{
+		{OpAdd, RoundDown, 0, []string{"1.5", "2.25", "0.00000001"}, "3.75000001"},
+		{OpSub, RoundDown, 0, []string{"5.0", "1.5", "0.5"}, "3.00000000"},
+		{OpMul, RoundDown, 0, []string{"20.01", "40.101"}, "802.42101000"},
+		{OpMulRound, RoundHalfEven, 2, []string{"1.99999999", "1.01"}, "2.02000000"},
+		{OpRound, RoundUp, 1, []string{"1.23"}, "1.30000000"},
+	} {
+		var operands []Decimal
+		for _, operand := range example.operands {
+			operands = append(operands, Must(FromString(operand)))
+		}
+
+		operation, err := NewOperation(
+			example.op,
+			example.rounding,
+			example.places,
+			operands...,
+		)
+		test.NoError(err, example.op)
+		test.Equal(example.expected, operation.Result.String(), example.op)
+		test.NoError(operation.Verify(), example.op)
+	}
+}
+
+func TestOperation_Verify_DoesNotReportInexactAgain(t *testing.T) {
+	test := assert.New(t)
+
+	records := recordInexact(t)
+
+	rounded, err := NewOperation(OpRound, RoundUp, 1, Must(FromString("1.23")))
+	test.NoError(err)
+
+	multiplied, err := NewOperation(
+		OpMulRound,
+		RoundHalfEven,
+		2,
+		Must(FromString("1.99999999")),
+		Must(FromString("1.01")),
+	)
+	test.NoError(err)
+	test.Len(*records, 2)
+
+	test.NoError(rounded.Verify())
+	test.NoError(multiplied.Verify())
+	test.Len(*records, 2)
+
+	_, err = rounded.Compute()
+	test.NoError(err)
+	test.Len(*records, 3)
+}
+
+func TestNewOperation_ReturnsErrorOnInvalidOperation(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := NewOperation(OpSub, RoundDown, 0, 1, 2)
+	test.Error(err)
+
+	_, err = NewOperation(OpMul, RoundDown, 0, 1)
+	test.Error(err)
+	test.Contains(err.Error(), "expects 2 operands")
+
+	_, err = NewOperation(OpMulRound, RoundDown, 9, 1, 1)
+	test.Error(err)
+
+	_, err = NewOperation("div", RoundDown, 0, 1, 1)
+	test.Error(err)
+	test.Contains(err.Error(), "unknown operation")
+}
+
+func TestOperationWriter_RoundTripsThroughReader(t *testing.T) {
+	test := assert.New(t)
+
+	first, err := NewOperation(
+		OpMulRound,
+		RoundHalfEven,
+		2,
+		Must(FromString("1.99999999")),
+		Must(FromString("1.01")),
+	)
+	test.NoError(err)
+
+	second, err := NewOperation(OpAdd, RoundDown, 0)
+	test.NoError(err)
+
+	var buffer bytes.Buffer
+	writer := NewOperationWriter(&buffer)
+	test.NoError(writer.Write(first))
+	test.NoError(writer.Write(second))
+	test.NoError(writer.Flush())
+
+	test.Equal(
+		"mulround half-even 2 2.02000000 1.99999999 1.01000000\n"+
+			"add down 0 0.00000000\n",
+		buffer.String(),
+	)
+
+	reader := NewOperationReader(&buffer)
+
+	actual, err := reader.Read()
+	test.NoError(err)
+	test.Equal(first, actual)
+
+	actual, err = reader.Read()
+	test.NoError(err)
+	test.Equal(second, actual)
+
+	_, err = reader.Read()
+	test.Equal(io.EOF, err)
+}
+
+func TestVerifyOperations_ReportsMismatchLine(t *testing.T) {
+	test := assert.New(t)
+
+	err := VerifyOperations(strings.NewReader(
+		"add down 0 3.00000000 1.00000000 2.00000000\n" +
+			"\n" +
+			"round half-up 0 2.00000000 2.50000000\n",
+	))
+	test.Error(err)
+	test.Contains(err.Error(), "line 3")
+	test.Contains(err.Error(), "replayed to 3.00000000")
+
+	err = VerifyOperations(strings.NewReader("add sideways 0 0.0\n"))
+	test.Error(err)
+	test.Contains(err.Error(), "line 1")
+}
@@ -0,0 +1,49 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// Packed is (lo, scale) layout of decimal number used in FlatBuffers and
+// Cap'n Proto schemas: value is Lo × 10^-Scale.
+type Packed struct {
+	Lo    uint64
+	Scale uint8
+}
+
+// Pack returns value in Packed layout with scale of 8.
+func (decimal Decimal) Pack() Packed {
+	return Packed{Lo: decimal.Uint64(), Scale: uint8(MaxPointsFractional)}
+}
+
+// FromPacked returns Decimal from Packed layout. Function will return error
+// if value can't be stored in Decimal type without loosing precision.
+func FromPacked(packed Packed) (Decimal, error) {
+	scale := int(packed.Scale)
+
+	if scale > MaxPointsFractional {
+		shift := scale - MaxPointsFractional
+		if shift >= len(powers) || packed.Lo%powers[shift] != 0 {
+			return 0, fmt.Errorf(
+				"decimal type can't hold fractional part of packed value: "+
+					"%d × 10^-%d",
+				packed.Lo,
+				scale,
+			)
+		}
+
+		return Decimal(packed.Lo / powers[shift]), nil
+	}
+
+	factor := powers[MaxPointsFractional-scale]
+	if packed.Lo >= Max/factor {
+		return 0, fmt.Errorf(
+			"decimal type can't hold integer part of packed value: "+
+				"%d × 10^-%d",
+			packed.Lo,
+			scale,
+		)
+	}
+
+	return Decimal(packed.Lo * factor), nil
+}
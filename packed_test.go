@@ -0,0 +1,51 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_Pack_RoundTrips(t *testing.T) {
+	test := assert.New(t)
+
+	value := Must(FromString("1234.5678"))
+
+	packed := value.Pack()
+	test.Equal(Packed{Lo: 123456780000, Scale: 8}, packed)
+
+	actual, err := FromPacked(packed)
+	test.NoError(err)
+	test.Equal(value, actual)
+}
+
+func TestFromPacked_ConvertsOtherScales(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := FromPacked(Packed{Lo: 12345678, Scale: 4})
+	test.NoError(err)
+	test.Equal("1234.56780000", actual.String())
+
+	actual, err = FromPacked(Packed{Lo: 1500000000000000000, Scale: 18})
+	test.NoError(err)
+	test.Equal("1.50000000", actual.String())
+
+	actual, err = FromPacked(Packed{Lo: 99999999999, Scale: 0})
+	test.NoError(err)
+	test.Equal("99999999999.00000000", actual.String())
+}
+
+func TestFromPacked_ReturnsErrorOnLostPrecision(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := FromPacked(Packed{Lo: 1, Scale: 9})
+	test.Error(err)
+	test.Contains(err.Error(), "fractional part")
+
+	_, err = FromPacked(Packed{Lo: 1, Scale: 255})
+	test.Error(err)
+
+	_, err = FromPacked(Packed{Lo: 100000000000, Scale: 0})
+	test.Error(err)
+	test.Contains(err.Error(), "integer part")
+}
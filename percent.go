@@ -0,0 +1,131 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// bigPercent is 100 in units of 0.00000001, it must not be modified.
+var bigPercent = new(big.Int).SetUint64(100 * MaxFractional)
+
+// PercentOf returns given percent of current value rounded with given mode.
+// Method will return error if result can't be stored in Decimal type.
+//
+// Example:
+//	decimal.Scan("200.0")
+//	decimal.PercentOf(decimal.Lit(25_00000000), decimal.RoundDown) // will return 50.00000000
+func (decimal Decimal) PercentOf(percent Decimal, mode RoundingMode) (Decimal, error) {
+	return decimal.percent("percent.of", bigDecimal(percent), mode)
+}
+
+// AddPercent returns current value increased by given percent, e.g. with
+// markup applied, rounded once with given mode. Method will return error if
+// result can't be stored in Decimal type.
+func (decimal Decimal) AddPercent(percent Decimal, mode RoundingMode) (Decimal, error) {
+	factor := new(big.Int).Add(bigPercent, bigDecimal(percent))
+
+	return decimal.percent("percent.add", factor, mode)
+}
+
+// SubPercent returns current value decreased by given percent, e.g. with
+// discount applied, rounded once with given mode. Method will return error
+// if percent is greater than 100.
+func (decimal Decimal) SubPercent(percent Decimal, mode RoundingMode) (Decimal, error) {
+	factor := new(big.Int).Sub(bigPercent, bigDecimal(percent))
+	if factor.Sign() < 0 {
+		return 0, fmt.Errorf(
+			"decimal type can't hold negative result of subtracting %s%% of %s",
+			percent.String(),
+			decimal.String(),
+		)
+	}
+
+	return decimal.percent("percent.sub", factor, mode)
+}
+
+// percent returns value × factor / 100 rounded with given mode, where factor
+// is in units of 0.00000001.
+func (decimal Decimal) percent(op string, factor *big.Int, mode RoundingMode) (Decimal, error) {
+	numerator := new(big.Int).Mul(bigDecimal(decimal), factor)
+
+	result, ok := fromBig(mode.apply(op, numerator, bigPercent, 1))
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold result of percent operation on %s",
+			decimal.String(),
+		)
+	}
+
+	return result, nil
+}
+
+// bigBasisPoints is 10000 as big.Int, it must not be modified.
+var bigBasisPoints = new(big.Int).SetUint64(10000)
+
+// Bps returns given number of basis points as fraction, e.g. 0.0025 for 25
+// basis points.
+//
+// Example:
+//	decimal.Bps(25) // will return 0.0025
+func Bps(bps uint32) Decimal {
+	return Decimal(uint64(bps) * (MaxFractional / 10000))
+}
+
+// ApplyBps returns given number of basis points of current value rounded
+// with given mode, e.g. fee charged on notional. Method will return error if
+// result can't be stored in Decimal type.
+//
+// Example:
+//	decimal.Scan("1000.0")
+//	decimal.ApplyBps(25, decimal.RoundUp) // will return 2.5
+func (decimal Decimal) ApplyBps(bps uint32, mode RoundingMode) (Decimal, error) {
+	numerator := new(big.Int).Mul(
+		bigDecimal(decimal),
+		new(big.Int).SetUint64(uint64(bps)),
+	)
+
+	result, ok := fromBig(mode.apply("bps.apply", numerator, bigBasisPoints, 1))
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold %d basis points of %s",
+			bps,
+			decimal.String(),
+		)
+	}
+
+	return result, nil
+}
+
+// BpsOf returns current value as number of basis points of other value,
+// e.g. effective fee rate of notional, rounded to 8 places with
+// DefaultPolicy().
+// Method will return error if other value is zero or result can't be stored
+// in Decimal type.
+//
+// Example:
+//	decimal.Scan("2.5")
+//	decimal.BpsOf(notional) // will return 25 for notional 1000
+func (decimal Decimal) BpsOf(other Decimal) (Decimal, error) {
+	if other == 0 {
+		return 0, fmt.Errorf(
+			"decimal value can't be expressed in basis points of zero: %s",
+			decimal.String(),
+		)
+	}
+
+	numerator := new(big.Int).Mul(bigDecimal(decimal), bigBasisPoints)
+	numerator.Mul(numerator, bigFractional)
+
+	result, ok := fromBig(
+		DefaultPolicy().Rounding.apply("bps.of", numerator, bigDecimal(other), 1),
+	)
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold basis points of %s in %s",
+			decimal.String(),
+			other.String(),
+		)
+	}
+
+	return result, nil
+}
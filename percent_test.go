@@ -0,0 +1,102 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_PercentOf_RoundsResult(t *testing.T) {
+	test := assert.New(t)
+
+	quarter := Must(FromString("0.25"))
+
+	actual, err := Must(FromString("200.0")).PercentOf(quarter, RoundDown)
+	test.NoError(err)
+	test.Equal("0.50000000", actual.String())
+
+	actual, err = Must(FromString("0.00000003")).PercentOf(Must(FromString("50.0")), RoundHalfEven)
+	test.NoError(err)
+	test.Equal("0.00000002", actual.String())
+
+	actual, err = Must(FromString("0.00000003")).PercentOf(Must(FromString("50.0")), RoundDown)
+	test.NoError(err)
+	test.Equal("0.00000001", actual.String())
+}
+
+func TestDecimal_AddPercent_AppliesMarkup(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Must(FromString("1234.5678")).AddPercent(Must(FromString("0.25")), RoundHalfUp)
+	test.NoError(err)
+	test.Equal("1237.65421950", actual.String())
+
+	actual, err = Must(FromString("0.00000001")).AddPercent(Must(FromString("0.25")), RoundUp)
+	test.NoError(err)
+	test.Equal("0.00000002", actual.String())
+
+	_, err = Must(FromString("99999999999.0")).AddPercent(Must(FromString("1.0")), RoundDown)
+	test.Error(err)
+}
+
+func TestDecimal_SubPercent_AppliesDiscount(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Must(FromString("200.0")).SubPercent(Must(FromString("12.5")), RoundDown)
+	test.NoError(err)
+	test.Equal("175.00000000", actual.String())
+
+	actual, err = Must(FromString("200.0")).SubPercent(Must(FromString("100.0")), RoundDown)
+	test.NoError(err)
+	test.Equal(Decimal(0), actual)
+
+	_, err = Must(FromString("200.0")).SubPercent(Must(FromString("100.00000001")), RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "negative result")
+}
+
+func TestBps_ReturnsFraction(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("0.00250000", Bps(25).String())
+	test.Equal("1.00000000", Bps(10000).String())
+	test.Equal(Decimal(0), Bps(0))
+	test.Equal("429496.72950000", Bps(^uint32(0)).String())
+}
+
+func TestDecimal_ApplyBps_RoundsResult(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Must(FromString("1000.0")).ApplyBps(25, RoundUp)
+	test.NoError(err)
+	test.Equal("2.50000000", actual.String())
+
+	actual, err = Must(FromString("0.00001")).ApplyBps(25, RoundUp)
+	test.NoError(err)
+	test.Equal("0.00000003", actual.String())
+
+	actual, err = Must(FromString("0.00001")).ApplyBps(25, RoundDown)
+	test.NoError(err)
+	test.Equal("0.00000002", actual.String())
+
+	_, err = Must(FromString("99999999999.0")).ApplyBps(20000, RoundDown)
+	test.Error(err)
+}
+
+func TestDecimal_BpsOf_ReturnsRate(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Must(FromString("2.5")).BpsOf(Must(FromString("1000.0")))
+	test.NoError(err)
+	test.Equal("25.00000000", actual.String())
+
+	actual, err = Must(FromString("1.0")).BpsOf(Must(FromString("3.0")))
+	test.NoError(err)
+	test.Equal("3333.33333333", actual.String())
+
+	_, err = Must(FromString("1.0")).BpsOf(0)
+	test.Error(err)
+
+	_, err = Must(FromString("99999999999.0")).BpsOf(Decimal(1))
+	test.Error(err)
+}
@@ -0,0 +1,105 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Breakpoint starts segment of Piecewise function: for x from At up to next
+// breakpoint, function equals Value + Slope × (x - At), or Value - Slope ×
+// (x - At) if Falling is set.
+type Breakpoint struct {
+	At      Decimal
+	Value   Decimal
+	Slope   Decimal
+	Falling bool
+}
+
+// Piecewise is piecewise linear function evaluated exactly, e.g. tiered
+// pricing or maker-incentive curve.
+//
+// Example:
+//	// 0.2% of volume up to 1000, 0.1% above it.
+//	fee, _ := decimal.NewPiecewise([]decimal.Breakpoint{
+//		{At: 0, Value: 0, Slope: decimal.Must(decimal.FromString("0.002"))},
+//		{At: thousand, Value: two, Slope: decimal.Must(decimal.FromString("0.001"))},
+//	})
+//	fee.Evaluate(volume, decimal.RoundUp)
+type Piecewise struct {
+	breakpoints []Breakpoint
+}
+
+// NewPiecewise returns Piecewise with given breakpoints or error if there
+// are no breakpoints or they are not sorted by At in strictly ascending
+// order.
+func NewPiecewise(breakpoints []Breakpoint) (Piecewise, error) {
+	if len(breakpoints) == 0 {
+		return Piecewise{}, fmt.Errorf("piecewise function needs breakpoints")
+	}
+
+	for i := 1; i < len(breakpoints); i++ {
+		if breakpoints[i].At <= breakpoints[i-1].At {
+			return Piecewise{}, fmt.Errorf(
+				"piecewise breakpoints should be in ascending order: %s after %s",
+				breakpoints[i].At.String(),
+				breakpoints[i-1].At.String(),
+			)
+		}
+	}
+
+	return Piecewise{
+		breakpoints: append([]Breakpoint(nil), breakpoints...),
+	}, nil
+}
+
+// Breakpoints returns copy of breakpoints of function.
+func (piecewise Piecewise) Breakpoints() []Breakpoint {
+	return append([]Breakpoint(nil), piecewise.breakpoints...)
+}
+
+// Evaluate returns value of function at x computed exactly and rounded once
+// with given mode. Function will return error if x is below first
+// breakpoint or result is negative or can't be stored in Decimal type.
+func (piecewise Piecewise) Evaluate(x Decimal, mode RoundingMode) (Decimal, error) {
+	index, ok := -1, false
+	for i, breakpoint := range piecewise.breakpoints {
+		if breakpoint.At > x {
+			break
+		}
+
+		index, ok = i, true
+	}
+
+	if !ok {
+		return 0, fmt.Errorf(
+			"piecewise function is not defined at %s", x.String(),
+		)
+	}
+
+	breakpoint := piecewise.breakpoints[index]
+
+	var numerator big.Int
+	numerator.Set(product(breakpoint.Slope, x-breakpoint.At))
+	if breakpoint.Falling {
+		numerator.Neg(&numerator)
+	}
+
+	numerator.Add(&numerator, new(big.Int).Mul(bigDecimal(breakpoint.Value), bigFractional))
+
+	if numerator.Sign() < 0 {
+		return 0, fmt.Errorf(
+			"decimal type can't hold negative value of piecewise function at %s",
+			x.String(),
+		)
+	}
+
+	result, ok := fromBig(mode.apply("piecewise", &numerator, bigFractional, 1))
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold value of piecewise function at %s",
+			x.String(),
+		)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPiecewise_Evaluate_ComputesTieredPricing(t *testing.T) {
+	test := assert.New(t)
+
+	fee, err := NewPiecewise([]Breakpoint{
+		{At: 0, Value: 0, Slope: Must(FromString("0.002"))},
+		{
+			At:    Must(FromString("1000.0")),
+			Value: Must(FromString("2.0")),
+			Slope: Must(FromString("0.001")),
+		},
+	})
+	test.NoError(err)
+
+	for x, expected := range map[string]string{
+		"0.0":        "0.00000000",
+		"500.0":      "1.00000000",
+		"1000.0":     "2.00000000",
+		"1500.0":     "2.50000000",
+		"0.00000001": "0.00000001",
+	} {
+		actual, err := fee.Evaluate(Must(FromString(x)), RoundUp)
+		test.NoError(err, x)
+		test.Equal(expected, actual.String(), x)
+	}
+}
+
+func TestPiecewise_Evaluate_SupportsFallingSegments(t *testing.T) {
+	test := assert.New(t)
+
+	rebate, err := NewPiecewise([]Breakpoint{
+		{
+			At:      Must(FromString("1.0")),
+			Value:   Must(FromString("0.0005")),
+			Slope:   Must(FromString("0.0001")),
+			Falling: true,
+		},
+		{At: Must(FromString("6.0"))},
+	})
+	test.NoError(err)
+
+	actual, err := rebate.Evaluate(Must(FromString("3.5")), RoundDown)
+	test.NoError(err)
+	test.Equal("0.00025000", actual.String())
+
+	actual, err = rebate.Evaluate(Must(FromString("10.0")), RoundDown)
+	test.NoError(err)
+	test.Equal("0.00000000", actual.String())
+
+	_, err = rebate.Evaluate(Must(FromString("0.5")), RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "not defined")
+}
+
+func TestPiecewise_Evaluate_ReturnsErrorOnNegativeValue(t *testing.T) {
+	test := assert.New(t)
+
+	curve, err := NewPiecewise([]Breakpoint{
+		{Value: Must(FromString("1.0")), Slope: Must(FromString("1.0")), Falling: true},
+	})
+	test.NoError(err)
+
+	_, err = curve.Evaluate(Must(FromString("2.0")), RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "negative")
+}
+
+func TestNewPiecewise_ValidatesBreakpoints(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := NewPiecewise(nil)
+	test.Error(err)
+
+	_, err = NewPiecewise([]Breakpoint{{At: 2}, {At: 2}})
+	test.Error(err)
+	test.Contains(err.Error(), "ascending order")
+}
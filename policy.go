@@ -0,0 +1,61 @@
+package decimal
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Policy is house rounding rule applied by operations which don't take
+// explicit rounding mode: Resample(), Valuation(), Decimal.BpsOf(),
+// FormatFor() and RoundFor(); Features() reports it.
+//
+// Operations which take mode, e.g. Decimal.Round(), Money.Round() or
+// Rate.Convert(), and types configured with mode, e.g. RateTable, never
+// consult policy. FeeSchedule doesn't either: it rounds fees up unless
+// FeeSchedule.Rounding() is used, so house rule can't make it undercharge.
+type Policy struct {
+	// Rounding is mode used to round inexact results.
+	Rounding RoundingMode
+}
+
+// defaultPolicy is policy used until SetDefaultPolicy() is called.
+var defaultPolicy = Policy{Rounding: RoundHalfEven}
+
+var (
+	// policyValue holds policy stored by SetDefaultPolicy().
+	policyValue atomic.Value
+
+	// policySet is non-zero once SetDefaultPolicy() has been called.
+	policySet int32
+)
+
+// SetDefaultPolicy sets policy used by operations which don't take explicit
+// rounding mode. It's meant to be called once at startup, before any such
+// operation is performed, so all services of deployment apply the same
+// rounding rule. Function will return error if policy has been set already
+// or has unknown rounding mode.
+//
+// Until policy is set, results are rounded with RoundHalfEven.
+func SetDefaultPolicy(policy Policy) error {
+	if _, ok := roundingModes[policy.Rounding.String()]; !ok {
+		return fmt.Errorf("unknown rounding mode: %s", policy.Rounding)
+	}
+
+	if !atomic.CompareAndSwapInt32(&policySet, 0, 1) {
+		return fmt.Errorf("default policy has been set already")
+	}
+
+	policyValue.Store(policy)
+
+	return nil
+}
+
+// DefaultPolicy returns policy used by operations which don't take explicit
+// rounding mode, see SetDefaultPolicy().
+func DefaultPolicy() Policy {
+	if policy, ok := policyValue.Load().(Policy); ok {
+		return policy
+	}
+
+	return defaultPolicy
+}
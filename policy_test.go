@@ -0,0 +1,63 @@
+package decimal
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// resetDefaultPolicy restores policy used before SetDefaultPolicy() call.
+func resetDefaultPolicy() {
+	policyValue = atomic.Value{}
+	policySet = 0
+}
+
+func TestSetDefaultPolicy_SetsOnce(t *testing.T) {
+	test := assert.New(t)
+	defer resetDefaultPolicy()
+
+	test.Equal(Policy{Rounding: RoundHalfEven}, DefaultPolicy())
+
+	test.NoError(SetDefaultPolicy(Policy{Rounding: RoundDown}))
+	test.Equal(Policy{Rounding: RoundDown}, DefaultPolicy())
+
+	err := SetDefaultPolicy(Policy{Rounding: RoundUp})
+	test.Error(err)
+	test.Contains(err.Error(), "set already")
+	test.Equal(Policy{Rounding: RoundDown}, DefaultPolicy())
+}
+
+func TestSetDefaultPolicy_UnknownRounding(t *testing.T) {
+	test := assert.New(t)
+	defer resetDefaultPolicy()
+
+	test.Error(SetDefaultPolicy(Policy{Rounding: RoundingMode(42)}))
+
+	test.NoError(SetDefaultPolicy(Policy{Rounding: RoundUp}))
+}
+
+func TestDefaultPolicy_AppliedByOperations(t *testing.T) {
+	test := assert.New(t)
+	defer resetDefaultPolicy()
+
+	three := Must(FromString("3.0"))
+
+	actual, err := Decimal(2).BpsOf(three)
+	test.NoError(err)
+	test.Equal("0.00006667", actual.String())
+
+	test.NoError(SetDefaultPolicy(Policy{Rounding: RoundDown}))
+
+	actual, err = Decimal(2).BpsOf(three)
+	test.NoError(err)
+	test.Equal("0.00006666", actual.String())
+
+	total, _, err := Valuation(
+		map[string]Decimal{"BTC": Decimal(2)},
+		map[string]Rate{"BTC": {Base: "BTC", Quote: "USD", Price: Must(FromString("0.75"))}},
+		"USD",
+	)
+	test.NoError(err)
+	test.Equal(Decimal(1), total)
+}
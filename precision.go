@@ -0,0 +1,224 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+// MarketPrecision contains precision rules of single market, e.g. as
+// configured for exchange matching engine.
+type MarketPrecision struct {
+	// PricePlaces and AmountPlaces are maximum numbers of digits after
+	// decimal point of price and amount, from 0 to 8.
+	PricePlaces  int
+	AmountPlaces int
+
+	// Tick is price step and Lot is amount step, prices and amounts should
+	// be their multiples. Zero means no step beyond number of places.
+	Tick Decimal
+	Lot  Decimal
+
+	// MinNotional is minimal price × amount of order, zero means no
+	// minimum.
+	MinNotional Decimal
+}
+
+// check returns error if rules are inconsistent.
+func (precision MarketPrecision) check() error {
+	for _, places := range []int{precision.PricePlaces, precision.AmountPlaces} {
+		if places < 0 || places > MaxPointsFractional {
+			return fmt.Errorf(
+				"number of places should be from 0 to %d: %d",
+				MaxPointsFractional,
+				places,
+			)
+		}
+	}
+
+	if precision.Tick.Places() > precision.PricePlaces {
+		return fmt.Errorf(
+			"tick size %s has more than %d places",
+			precision.Tick.String(),
+			precision.PricePlaces,
+		)
+	}
+
+	if precision.Lot.Places() > precision.AmountPlaces {
+		return fmt.Errorf(
+			"lot size %s has more than %d places",
+			precision.Lot.String(),
+			precision.AmountPlaces,
+		)
+	}
+
+	return nil
+}
+
+// Validate returns descriptive error if given price or amount violates
+// rules, i.e. has too many places, is not multiple of step or order
+// notional is below minimum.
+func (precision MarketPrecision) Validate(price, amount Decimal) error {
+	if price.Places() > precision.PricePlaces {
+		return fmt.Errorf(
+			"price %s has more than %d places", price.String(), precision.PricePlaces,
+		)
+	}
+
+	if precision.Tick != 0 && price%precision.Tick != 0 {
+		return fmt.Errorf(
+			"price %s is not multiple of tick size %s",
+			price.String(),
+			precision.Tick.String(),
+		)
+	}
+
+	if amount.Places() > precision.AmountPlaces {
+		return fmt.Errorf(
+			"amount %s has more than %d places", amount.String(), precision.AmountPlaces,
+		)
+	}
+
+	if precision.Lot != 0 && amount%precision.Lot != 0 {
+		return fmt.Errorf(
+			"amount %s is not multiple of lot size %s",
+			amount.String(),
+			precision.Lot.String(),
+		)
+	}
+
+	notional := product(price, amount)
+	minimum := new(big.Int).Mul(bigDecimal(precision.MinNotional), bigFractional)
+
+	if notional.Cmp(minimum) < 0 {
+		return fmt.Errorf(
+			"notional %s × %s is below minimum %s",
+			price.String(),
+			amount.String(),
+			precision.MinNotional.String(),
+		)
+	}
+
+	return nil
+}
+
+// Normalize returns given price and amount truncated to places and steps
+// of rules, so neither of them is ever increased, and validated by
+// Validate(), e.g. to reject order which falls below minimum notional after
+// truncation.
+func (precision MarketPrecision) Normalize(price, amount Decimal) (Decimal, Decimal, error) {
+	// Truncation never overflows.
+	price, _ = price.Round(precision.PricePlaces, RoundDown)
+	amount, _ = amount.Round(precision.AmountPlaces, RoundDown)
+
+	if precision.Tick != 0 {
+		price -= price % precision.Tick
+	}
+
+	if precision.Lot != 0 {
+		amount -= amount % precision.Lot
+	}
+
+	if err := precision.Validate(price, amount); err != nil {
+		return 0, 0, err
+	}
+
+	return price, amount, nil
+}
+
+// PrecisionRegistry stores precision rules per market. It's safe for
+// concurrent use, so rules can be updated while orders are validated.
+//
+// Example:
+//	registry := decimal.NewPrecisionRegistry()
+//	registry.Register("btcusd", decimal.MarketPrecision{PricePlaces: 2, AmountPlaces: 6})
+//	price, amount, err := registry.Normalize("btcusd", price, amount)
+type PrecisionRegistry struct {
+	mutex   sync.RWMutex
+	markets map[string]MarketPrecision
+}
+
+// NewPrecisionRegistry returns empty PrecisionRegistry.
+func NewPrecisionRegistry() *PrecisionRegistry {
+	return &PrecisionRegistry{markets: map[string]MarketPrecision{}}
+}
+
+// Register sets rules of given market, replacing previous ones. Method will
+// return error if rules are inconsistent, e.g. tick size has more places
+// than price.
+func (registry *PrecisionRegistry) Register(market string, precision MarketPrecision) error {
+	if err := precision.check(); err != nil {
+		return fmt.Errorf("market %s: %s", market, err)
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.markets[market] = precision
+
+	return nil
+}
+
+// Market returns rules of given market or error if market is not
+// registered.
+func (registry *PrecisionRegistry) Market(market string) (MarketPrecision, error) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	precision, ok := registry.markets[market]
+	if !ok {
+		return MarketPrecision{}, fmt.Errorf("market %s is not registered", market)
+	}
+
+	return precision, nil
+}
+
+// Markets returns registered markets, sorted.
+func (registry *PrecisionRegistry) Markets() []string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	markets := make([]string, 0, len(registry.markets))
+	for market := range registry.markets {
+		markets = append(markets, market)
+	}
+
+	sort.Strings(markets)
+
+	return markets
+}
+
+// Validate validates price and amount of order in given market, see
+// MarketPrecision.Validate(). Returned error names market.
+func (registry *PrecisionRegistry) Validate(market string, price, amount Decimal) error {
+	precision, err := registry.Market(market)
+	if err != nil {
+		return err
+	}
+
+	if err := precision.Validate(price, amount); err != nil {
+		return fmt.Errorf("market %s: %s", market, err)
+	}
+
+	return nil
+}
+
+// Normalize normalizes price and amount of order in given market, see
+// MarketPrecision.Normalize(). Returned error names market.
+func (registry *PrecisionRegistry) Normalize(
+	market string,
+	price, amount Decimal,
+) (Decimal, Decimal, error) {
+	precision, err := registry.Market(market)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	price, amount, err = precision.Normalize(price, amount)
+	if err != nil {
+		return 0, 0, fmt.Errorf("market %s: %s", market, err)
+	}
+
+	return price, amount, nil
+}
@@ -0,0 +1,99 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPrecisionRegistry(t *testing.T) *PrecisionRegistry {
+	registry := NewPrecisionRegistry()
+
+	err := registry.Register("btcusd", MarketPrecision{
+		PricePlaces:  2,
+		AmountPlaces: 6,
+		Tick:         Must(FromString("0.5")),
+		Lot:          Must(FromString("0.0001")),
+		MinNotional:  Must(FromString("10.0")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return registry
+}
+
+func TestPrecisionRegistry_Validate(t *testing.T) {
+	test := assert.New(t)
+
+	registry := newTestPrecisionRegistry(t)
+
+	test.NoError(registry.Validate("btcusd", Must(FromString("20000.5")), Must(FromString("0.0005"))))
+
+	for _, c := range []struct {
+		price, amount, message string
+	}{
+		{"20000.505", "1.0", "more than 2 places"},
+		{"20000.25", "1.0", "not multiple of tick size 0.50000000"},
+		{"20000.5", "0.0000001", "more than 6 places"},
+		{"20000.5", "0.00015", "not multiple of lot size 0.00010000"},
+		{"20000.0", "0.0001", "below minimum 10.00000000"},
+	} {
+		err := registry.Validate("btcusd", Must(FromString(c.price)), Must(FromString(c.amount)))
+		test.Error(err, c)
+
+		if err != nil {
+			test.Contains(err.Error(), "market btcusd: ", c)
+			test.Contains(err.Error(), c.message, c)
+		}
+	}
+
+	test.Error(registry.Validate("ethusd", 1, 1))
+}
+
+func TestPrecisionRegistry_Normalize(t *testing.T) {
+	test := assert.New(t)
+
+	registry := newTestPrecisionRegistry(t)
+
+	price, amount, err := registry.Normalize(
+		"btcusd",
+		Must(FromString("20000.789")),
+		Must(FromString("0.00123456")),
+	)
+	test.NoError(err)
+	test.Equal("20000.50000000", price.String())
+	test.Equal("0.00120000", amount.String())
+
+	_, _, err = registry.Normalize("btcusd", Must(FromString("20000.0")), Must(FromString("0.00049")))
+	test.Error(err)
+	test.Contains(err.Error(), "below minimum")
+
+	_, _, err = registry.Normalize("ethusd", 1, 1)
+	test.Error(err)
+}
+
+func TestPrecisionRegistry_Register(t *testing.T) {
+	test := assert.New(t)
+
+	registry := newTestPrecisionRegistry(t)
+
+	test.NoError(registry.Register("ethusd", MarketPrecision{PricePlaces: 8, AmountPlaces: 8}))
+	test.Equal([]string{"btcusd", "ethusd"}, registry.Markets())
+
+	precision, err := registry.Market("ethusd")
+	test.NoError(err)
+	test.NoError(precision.Validate(1, 1))
+
+	for _, precision := range []MarketPrecision{
+		{PricePlaces: 9},
+		{AmountPlaces: -1},
+		{PricePlaces: 0, Tick: Must(FromString("0.5"))},
+		{AmountPlaces: 2, Lot: Must(FromString("0.001"))},
+	} {
+		test.Error(registry.Register("xrpusd", precision), precision)
+	}
+
+	_, err = registry.Market("xrpusd")
+	test.Error(err)
+}
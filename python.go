@@ -0,0 +1,71 @@
+package decimal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PyString returns representation produced by str() of Python's
+// decimal.Decimal holding the same value with exponent of -8, so Python
+// parses it into exactly the same value and quantum. Values smaller than
+// 0.000001 use exponent notation, as Python does.
+//
+// Example:
+//	decimal.Scan("1.5")
+//	decimal.PyString() // will return "1.50000000"
+//	decimal.Scan("0.00000001")
+//	decimal.PyString() // will return "1E-8"
+func (decimal Decimal) PyString() string {
+	digits := strconv.FormatUint(decimal.Uint64(), 10)
+	adjusted := len(digits) - 1 - MaxPointsFractional
+
+	if adjusted >= -6 {
+		return decimal.String()
+	}
+
+	text := digits[:1]
+	if len(digits) > 1 {
+		text += "." + digits[1:]
+	}
+
+	return text + "E" + strconv.Itoa(adjusted)
+}
+
+// PyRepr returns representation produced by repr() of Python's
+// decimal.Decimal holding the same value.
+//
+// Example:
+//	decimal.Scan("1.5")
+//	decimal.PyRepr() // will return "Decimal('1.50000000')"
+func (decimal Decimal) PyRepr() string {
+	return "Decimal('" + decimal.PyString() + "')"
+}
+
+// ParsePyRepr returns Decimal parsed from output of repr() of Python's
+// decimal.Decimal, e.g. "Decimal('1E-8')". Input must be exactly in repr()
+// format; negative values, NaN and Infinity are rejected.
+func ParsePyRepr(value string) (Decimal, error) {
+	const prefix, suffix = "Decimal('", "')"
+
+	if !strings.HasPrefix(value, prefix) || !strings.HasSuffix(value, suffix) ||
+		len(value) < len(prefix)+len(suffix) {
+		return 0, fmt.Errorf(
+			"decimal type expected Python repr, but received: %q", value,
+		)
+	}
+
+	return ParsePyString(value[len(prefix) : len(value)-len(suffix)])
+}
+
+// ParsePyString returns Decimal parsed from output of str() of Python's
+// decimal.Decimal, e.g. "1E-8" or "1.50000000".
+func ParsePyString(value string) (Decimal, error) {
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		return 0, fmt.Errorf(
+			"decimal type can't hold signed Python value: %q", value,
+		)
+	}
+
+	return parseScientific(value)
+}
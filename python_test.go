@@ -0,0 +1,75 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_PyString_MatchesPython(t *testing.T) {
+	test := assert.New(t)
+
+	for value, expected := range map[string]string{
+		"0.0":                  "0E-8",
+		"0.00000001":           "1E-8",
+		"0.00000099":           "9.9E-7",
+		"0.000001":             "0.00000100",
+		"1.5":                  "1.50000000",
+		"99999999999.99999999": "99999999999.99999999",
+	} {
+		test.Equal(expected, Must(FromString(value)).PyString(), value)
+	}
+}
+
+func TestDecimal_PyRepr_RoundTrips(t *testing.T) {
+	test := assert.New(t)
+
+	for _, value := range []string{"0.0", "0.00000012", "0.1", "12345.6789"} {
+		expected := Must(FromString(value))
+
+		actual, err := ParsePyRepr(expected.PyRepr())
+		test.NoError(err, value)
+		test.Equal(expected, actual, value)
+	}
+
+	test.Equal("Decimal('1.50000000')", Must(FromString("1.5")).PyRepr())
+}
+
+func TestParsePyRepr_AcceptsPythonForms(t *testing.T) {
+	test := assert.New(t)
+
+	for value, expected := range map[string]string{
+		"Decimal('1.5')":     "1.50000000",
+		"Decimal('1E+2')":    "100.00000000",
+		"Decimal('1.5E-7')":  "0.00000015",
+		"Decimal('0E-12')":   "0.00000000",
+		"Decimal('15')":      "15.00000000",
+		"Decimal('1.00E-8')": "0.00000001",
+	} {
+		actual, err := ParsePyRepr(value)
+		test.NoError(err, value)
+		test.Equal(expected, actual.String(), value)
+	}
+}
+
+func TestParsePyRepr_IsStrict(t *testing.T) {
+	test := assert.New(t)
+
+	for _, value := range []string{
+		"1.5",
+		"Decimal(1.5)",
+		"Decimal('-1.5')",
+		"Decimal('NaN')",
+		"Decimal('Infinity')",
+		"Decimal(' 1.5')",
+		"Decimal('1E-9')",
+		"Decimal('1E+11')",
+		"Decimal('1E+9223372036854775807')",
+		"Decimal('0.111111111E-9223372036854775808')",
+		"Decimal('')",
+		"Decimal(')",
+	} {
+		_, err := ParsePyRepr(value)
+		test.Error(err, value)
+	}
+}
@@ -0,0 +1,115 @@
+package decimal
+
+import (
+	"container/heap"
+)
+
+// Queue is priority queue of payloads keyed by Decimal. Min queue pops
+// smallest key first, max queue pops largest key first; payloads with equal
+// keys are popped in order they were pushed.
+//
+// Example:
+//	asks := decimal.NewMinQueue[string]()
+//	asks.Push(decimal.Must(decimal.FromString("101.0")), "order-2")
+//	asks.Push(decimal.Must(decimal.FromString("100.0")), "order-1")
+//	asks.Pop() // will return 100.00000000, "order-1", true
+type Queue[T any] struct {
+	items queueItems[T]
+}
+
+// NewMinQueue returns empty Queue popping smallest key first.
+func NewMinQueue[T any]() *Queue[T] {
+	return &Queue[T]{}
+}
+
+// NewMaxQueue returns empty Queue popping largest key first.
+func NewMaxQueue[T any]() *Queue[T] {
+	return &Queue[T]{items: queueItems[T]{max: true}}
+}
+
+// Push adds payload with given key.
+func (queue *Queue[T]) Push(key Decimal, payload T) {
+	queue.items.sequence++
+
+	heap.Push(&queue.items, queueItem[T]{
+		key:      key,
+		payload:  payload,
+		sequence: queue.items.sequence,
+	})
+}
+
+// Pop removes and returns payload with highest priority and its key. It
+// returns false if queue is empty.
+func (queue *Queue[T]) Pop() (Decimal, T, bool) {
+	if queue.Len() == 0 {
+		var empty T
+		return 0, empty, false
+	}
+
+	item := heap.Pop(&queue.items).(queueItem[T])
+
+	return item.key, item.payload, true
+}
+
+// Peek returns payload with highest priority and its key without removing
+// it. It returns false if queue is empty.
+func (queue *Queue[T]) Peek() (Decimal, T, bool) {
+	if queue.Len() == 0 {
+		var empty T
+		return 0, empty, false
+	}
+
+	item := queue.items.list[0]
+
+	return item.key, item.payload, true
+}
+
+// Len returns number of payloads in queue.
+func (queue *Queue[T]) Len() int {
+	return len(queue.items.list)
+}
+
+type queueItem[T any] struct {
+	key      Decimal
+	payload  T
+	sequence uint64
+}
+
+// queueItems implements heap.Interface.
+type queueItems[T any] struct {
+	list     []queueItem[T]
+	max      bool
+	sequence uint64
+}
+
+func (items *queueItems[T]) Len() int {
+	return len(items.list)
+}
+
+func (items *queueItems[T]) Less(i, j int) bool {
+	a, b := items.list[i], items.list[j]
+
+	if a.key != b.key {
+		return (a.key < b.key) != items.max
+	}
+
+	return a.sequence < b.sequence
+}
+
+func (items *queueItems[T]) Swap(i, j int) {
+	items.list[i], items.list[j] = items.list[j], items.list[i]
+}
+
+func (items *queueItems[T]) Push(item interface{}) {
+	items.list = append(items.list, item.(queueItem[T]))
+}
+
+func (items *queueItems[T]) Pop() interface{} {
+	last := len(items.list) - 1
+	item := items.list[last]
+
+	items.list[last] = queueItem[T]{}
+	items.list = items.list[:last]
+
+	return item
+}
@@ -0,0 +1,63 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueue_PopsInPriorityOrder(t *testing.T) {
+	test := assert.New(t)
+
+	asks := NewMinQueue[string]()
+	bids := NewMaxQueue[string]()
+
+	for _, order := range []struct {
+		price string
+		id    string
+	}{
+		{"101.0", "a"},
+		{"100.0", "b"},
+		{"102.0", "c"},
+		{"100.0", "d"},
+	} {
+		asks.Push(Must(FromString(order.price)), order.id)
+		bids.Push(Must(FromString(order.price)), order.id)
+	}
+
+	drain := func(queue *Queue[string]) []string {
+		var ids []string
+		for queue.Len() > 0 {
+			_, id, ok := queue.Pop()
+			test.True(ok)
+			ids = append(ids, id)
+		}
+
+		return ids
+	}
+
+	test.Equal([]string{"b", "d", "a", "c"}, drain(asks))
+	test.Equal([]string{"c", "a", "b", "d"}, drain(bids))
+}
+
+func TestQueue_Peek_DoesNotRemove(t *testing.T) {
+	test := assert.New(t)
+
+	queue := NewMinQueue[int]()
+
+	_, _, ok := queue.Peek()
+	test.False(ok)
+
+	queue.Push(Must(FromString("1.5")), 42)
+
+	key, payload, ok := queue.Peek()
+	test.True(ok)
+	test.Equal("1.50000000", key.String())
+	test.Equal(42, payload)
+	test.Equal(1, queue.Len())
+
+	queue.Pop()
+
+	_, _, ok = queue.Pop()
+	test.False(ok)
+}
@@ -0,0 +1,99 @@
+package decimal
+
+import (
+	"fmt"
+	"sync"
+)
+
+// NotionalQuota limits sum of notionals of items in flight, e.g. exposure
+// of orders sent to exchange but not yet filled. Item is admitted by
+// reserving its notional, which is returned to quota on release.
+//
+// NotionalQuota is safe for concurrent use.
+//
+// Example:
+//	quota := decimal.NewNotionalQuota(decimal.Must(decimal.FromString("1000.0")))
+//	reservation, err := quota.Reserve(notional)
+//	if err != nil {
+//		return err // rejected: in-flight exposure would exceed limit
+//	}
+//	defer reservation.Release()
+type NotionalQuota struct {
+	mutex sync.Mutex
+
+	limit    Decimal
+	reserved Decimal
+}
+
+// QuotaReservation is notional reserved by NotionalQuota.Reserve().
+type QuotaReservation struct {
+	quota    *NotionalQuota
+	notional Decimal
+
+	once sync.Once
+}
+
+// NewNotionalQuota returns NotionalQuota admitting items while sum of their
+// notionals doesn't exceed given limit.
+func NewNotionalQuota(limit Decimal) *NotionalQuota {
+	return &NotionalQuota{limit: limit}
+}
+
+// Reserve reserves given notional and returns reservation which should be
+// released when item leaves flight. Method will return error if sum of
+// reserved notionals would exceed limit; quota is not changed on error.
+func (quota *NotionalQuota) Reserve(notional Decimal) (*QuotaReservation, error) {
+	quota.mutex.Lock()
+	defer quota.mutex.Unlock()
+
+	if notional > quota.limit-quota.reserved {
+		return nil, fmt.Errorf(
+			"notional quota can't admit %s, available: %s",
+			notional.String(),
+			(quota.limit - quota.reserved).String(),
+		)
+	}
+
+	quota.reserved += notional
+
+	return &QuotaReservation{quota: quota, notional: notional}, nil
+}
+
+// Available returns notional which can be reserved right now.
+func (quota *NotionalQuota) Available() Decimal {
+	quota.mutex.Lock()
+	defer quota.mutex.Unlock()
+
+	return quota.limit - quota.reserved
+}
+
+// Reserved returns sum of notionals currently reserved.
+func (quota *NotionalQuota) Reserved() Decimal {
+	quota.mutex.Lock()
+	defer quota.mutex.Unlock()
+
+	return quota.reserved
+}
+
+// Limit returns maximum sum of reserved notionals.
+func (quota *NotionalQuota) Limit() Decimal {
+	return quota.limit
+}
+
+// Notional returns reserved notional.
+func (reservation *QuotaReservation) Notional() Decimal {
+	return reservation.notional
+}
+
+// Release returns reserved notional to quota. Only first call has effect,
+// so it's safe to call it several times, e.g. in defer and on completion.
+func (reservation *QuotaReservation) Release() {
+	reservation.once.Do(func() {
+		quota := reservation.quota
+
+		quota.mutex.Lock()
+		defer quota.mutex.Unlock()
+
+		quota.reserved -= reservation.notional
+	})
+}
@@ -0,0 +1,75 @@
+package decimal
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotionalQuota_Reserve_AdmitsUpToLimit(t *testing.T) {
+	test := assert.New(t)
+
+	quota := NewNotionalQuota(Must(FromString("10.0")))
+
+	first, err := quota.Reserve(Must(FromString("7.5")))
+	test.NoError(err)
+	test.Equal("7.50000000", first.Notional().String())
+
+	_, err = quota.Reserve(Must(FromString("2.50000001")))
+	test.Error(err)
+	test.Contains(err.Error(), "can't admit")
+	test.Equal("2.50000000", quota.Available().String())
+
+	second, err := quota.Reserve(Must(FromString("2.5")))
+	test.NoError(err)
+	test.Equal(Decimal(0), quota.Available())
+	test.Equal("10.00000000", quota.Reserved().String())
+
+	second.Release()
+	test.Equal("2.50000000", quota.Available().String())
+}
+
+func TestQuotaReservation_Release_IsIdempotent(t *testing.T) {
+	test := assert.New(t)
+
+	quota := NewNotionalQuota(Must(FromString("10.0")))
+
+	reservation, err := quota.Reserve(Must(FromString("4.0")))
+	test.NoError(err)
+
+	reservation.Release()
+	reservation.Release()
+
+	test.Equal("10.00000000", quota.Available().String())
+	test.Equal(Decimal(0), quota.Reserved())
+}
+
+func TestNotionalQuota_Reserve_IsSafeForConcurrentUse(t *testing.T) {
+	test := assert.New(t)
+
+	quota := NewNotionalQuota(Must(FromString("1.0")))
+
+	var (
+		group    sync.WaitGroup
+		mutex    sync.Mutex
+		admitted int
+	)
+
+	for i := 0; i < 200; i++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+
+			if _, err := quota.Reserve(Must(FromString("0.01"))); err == nil {
+				mutex.Lock()
+				admitted++
+				mutex.Unlock()
+			}
+		}()
+	}
+	group.Wait()
+
+	test.Equal(100, admitted)
+	test.Equal(Decimal(0), quota.Available())
+}
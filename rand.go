@@ -0,0 +1,66 @@
+package decimal
+
+import (
+	crand "crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+)
+
+// Rand returns uniformly distributed random value from 0 to max exclusive
+// using given source, e.g. to generate load test orders. Every multiple of
+// 0.00000001 in range is equally likely. It panics if max is zero, like
+// rand.Int63n() does.
+//
+// Example:
+//	r := rand.New(rand.NewSource(42))
+//	decimal.Rand(r, decimal.Must(decimal.FromString("10.0")))
+func Rand(r *rand.Rand, max Decimal) Decimal {
+	if max == 0 {
+		panic("decimal: Rand max should be positive")
+	}
+
+	n := max.Uint64()
+
+	// Values below threshold contain whole number of ranges of n values, so
+	// accepting only them avoids modulo bias.
+	threshold := math.MaxUint64 - math.MaxUint64%n
+	for {
+		if value := r.Uint64(); value < threshold {
+			return Decimal(value % n)
+		}
+	}
+}
+
+// RandRange returns uniformly distributed random value from min inclusive
+// to max exclusive using given source, see Rand(). It panics if min is not
+// less than max.
+func RandRange(r *rand.Rand, min, max Decimal) Decimal {
+	if min >= max {
+		panic(fmt.Sprintf(
+			"decimal: RandRange min %s should be less than max %s",
+			min.String(),
+			max.String(),
+		))
+	}
+
+	return min + Rand(r, max-min)
+}
+
+// CryptoRand returns uniformly distributed random value from 0 to max
+// exclusive read from crypto/rand, e.g. for values which must not be
+// predictable. Function will return error if max is zero or random source
+// fails.
+func CryptoRand(max Decimal) (Decimal, error) {
+	if max == 0 {
+		return 0, fmt.Errorf("random decimal max should be positive")
+	}
+
+	value, err := crand.Int(crand.Reader, new(big.Int).SetUint64(max.Uint64()))
+	if err != nil {
+		return 0, err
+	}
+
+	return Decimal(value.Uint64()), nil
+}
@@ -0,0 +1,94 @@
+package decimal
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRand_StaysInRange(t *testing.T) {
+	test := assert.New(t)
+
+	r := rand.New(rand.NewSource(42))
+
+	for _, max := range []Decimal{1, 2, Must(FromString("10.0")), Decimal(Max - 1)} {
+		for i := 0; i < 1000; i++ {
+			value := Rand(r, max)
+			test.True(value < max, "%s < %s", value.String(), max.String())
+		}
+	}
+
+	test.Equal(Decimal(0), Rand(r, 1))
+	test.Panics(func() { Rand(r, 0) })
+}
+
+func TestRand_IsDeterministic(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("100.0"))
+
+	a := rand.New(rand.NewSource(7))
+	b := rand.New(rand.NewSource(7))
+
+	for i := 0; i < 100; i++ {
+		test.Equal(Rand(a, max), Rand(b, max))
+	}
+}
+
+func TestRand_IsUniform(t *testing.T) {
+	test := assert.New(t)
+
+	r := rand.New(rand.NewSource(1))
+
+	var counts [4]int
+	for i := 0; i < 40000; i++ {
+		counts[Rand(r, 4)]++
+	}
+
+	for value, count := range counts {
+		test.InDelta(10000, count, 500, "value %d", value)
+	}
+}
+
+func TestRandRange_StaysInRange(t *testing.T) {
+	test := assert.New(t)
+
+	r := rand.New(rand.NewSource(42))
+
+	min := Must(FromString("99.5"))
+	max := Must(FromString("100.5"))
+
+	seen := map[bool]bool{}
+	for i := 0; i < 1000; i++ {
+		value := RandRange(r, min, max)
+		test.True(value >= min && value < max, value.String())
+
+		seen[value >= Must(FromString("100.0"))] = true
+	}
+
+	test.Len(seen, 2)
+	test.Equal(min, RandRange(r, min, min+1))
+
+	test.Panics(func() { RandRange(r, max, max) })
+	test.Panics(func() { RandRange(r, max, min) })
+}
+
+func TestCryptoRand_StaysInRange(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("10.0"))
+
+	for i := 0; i < 100; i++ {
+		value, err := CryptoRand(max)
+		test.NoError(err)
+		test.True(value < max, value.String())
+	}
+
+	value, err := CryptoRand(1)
+	test.NoError(err)
+	test.Equal(Decimal(0), value)
+
+	_, err = CryptoRand(0)
+	test.Error(err)
+}
@@ -0,0 +1,124 @@
+package decimal
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Range is closed interval of values from Min to Max inclusive, e.g. price
+// band or allowed withdrawal amounts. Min should not be greater than Max.
+//
+// Range is encoded in JSON as object with "min" and "max" fields, decoding
+// returns error if Min is greater than Max.
+//
+// Example:
+//	band := decimal.Range{Min: low, Max: high}
+//	band.Contains(price)
+type Range struct {
+	Min Decimal `json:"min"`
+	Max Decimal `json:"max"`
+}
+
+// NewRange returns Range from min to max or error if min is greater than max.
+func NewRange(min, max Decimal) (Range, error) {
+	interval := Range{Min: min, Max: max}
+	if err := interval.Validate(); err != nil {
+		return Range{}, err
+	}
+
+	return interval, nil
+}
+
+// Validate returns error if Min is greater than Max.
+func (interval Range) Validate() error {
+	if interval.Min > interval.Max {
+		return fmt.Errorf(
+			"decimal range minimum %s is greater than maximum %s",
+			interval.Min.String(),
+			interval.Max.String(),
+		)
+	}
+
+	return nil
+}
+
+// String returns range in interval notation, e.g. "[1.00000000, 2.00000000]".
+func (interval Range) String() string {
+	return "[" + interval.Min.String() + ", " + interval.Max.String() + "]"
+}
+
+// Contains reports whether given value is in range.
+func (interval Range) Contains(value Decimal) bool {
+	return value >= interval.Min && value <= interval.Max
+}
+
+// Check returns error if given value is not in range, e.g. to validate
+// request.
+func (interval Range) Check(value Decimal) error {
+	if !interval.Contains(value) {
+		return fmt.Errorf(
+			"decimal value %s is out of range %s",
+			value.String(),
+			interval.String(),
+		)
+	}
+
+	return nil
+}
+
+// Clamp returns nearest value in range to given one, i.e. Min for values
+// below range and Max for values above it.
+func (interval Range) Clamp(value Decimal) Decimal {
+	switch {
+	case value < interval.Min:
+		return interval.Min
+	case value > interval.Max:
+		return interval.Max
+	default:
+		return value
+	}
+}
+
+// Intersect returns values which are in both ranges and false if ranges
+// don't overlap.
+func (interval Range) Intersect(other Range) (Range, bool) {
+	intersection := interval
+
+	if other.Min > intersection.Min {
+		intersection.Min = other.Min
+	}
+
+	if other.Max < intersection.Max {
+		intersection.Max = other.Max
+	}
+
+	if intersection.Min > intersection.Max {
+		return Range{}, false
+	}
+
+	return intersection, true
+}
+
+// Span returns width of range, i.e. Max less Min.
+func (interval Range) Span() Decimal {
+	return interval.Max - interval.Min
+}
+
+// UnmarshalJSON reads Range from JSON object and validates it.
+func (interval *Range) UnmarshalJSON(data []byte) error {
+	// plain has no methods, so it's decoded without recursion.
+	type plain Range
+
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	if err := Range(decoded).Validate(); err != nil {
+		return err
+	}
+
+	*interval = Range(decoded)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustRange(min, max string) Range {
+	return Range{Min: Must(FromString(min)), Max: Must(FromString(max))}
+}
+
+func TestNewRange_Validates(t *testing.T) {
+	test := assert.New(t)
+
+	interval, err := NewRange(1, 2)
+	test.NoError(err)
+	test.Equal(Range{Min: 1, Max: 2}, interval)
+
+	_, err = NewRange(1, 1)
+	test.NoError(err)
+
+	_, err = NewRange(2, 1)
+	test.Error(err)
+}
+
+func TestRange_Contains(t *testing.T) {
+	test := assert.New(t)
+
+	band := mustRange("1.0", "2.0")
+
+	test.True(band.Contains(Must(FromString("1.0"))))
+	test.True(band.Contains(Must(FromString("1.5"))))
+	test.True(band.Contains(Must(FromString("2.0"))))
+	test.False(band.Contains(Must(FromString("0.99999999"))))
+	test.False(band.Contains(Must(FromString("2.00000001"))))
+
+	test.NoError(band.Check(Must(FromString("1.5"))))
+
+	err := band.Check(Must(FromString("3.0")))
+	test.Error(err)
+	test.Contains(err.Error(), "3.00000000 is out of range [1.00000000, 2.00000000]")
+}
+
+func TestRange_Clamp(t *testing.T) {
+	test := assert.New(t)
+
+	band := mustRange("1.0", "2.0")
+
+	test.Equal(Must(FromString("1.0")), band.Clamp(Must(FromString("0.5"))))
+	test.Equal(Must(FromString("1.5")), band.Clamp(Must(FromString("1.5"))))
+	test.Equal(Must(FromString("2.0")), band.Clamp(Must(FromString("2.5"))))
+}
+
+func TestRange_Intersect(t *testing.T) {
+	test := assert.New(t)
+
+	a := mustRange("1.0", "3.0")
+
+	intersection, ok := a.Intersect(mustRange("2.0", "4.0"))
+	test.True(ok)
+	test.Equal(mustRange("2.0", "3.0"), intersection)
+
+	intersection, ok = a.Intersect(mustRange("1.5", "2.5"))
+	test.True(ok)
+	test.Equal(mustRange("1.5", "2.5"), intersection)
+
+	intersection, ok = a.Intersect(mustRange("3.0", "4.0"))
+	test.True(ok)
+	test.Equal(mustRange("3.0", "3.0"), intersection)
+
+	_, ok = a.Intersect(mustRange("3.00000001", "4.0"))
+	test.False(ok)
+}
+
+func TestRange_Span(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal(Must(FromString("1.5")), mustRange("0.5", "2.0").Span())
+	test.Equal(Decimal(0), mustRange("1.0", "1.0").Span())
+}
+
+func TestRange_JSON(t *testing.T) {
+	test := assert.New(t)
+
+	data, err := json.Marshal(mustRange("1.0", "2.0"))
+	test.NoError(err)
+	test.Equal(`{"min":"1.00000000","max":"2.00000000"}`, string(data))
+
+	var decoded Range
+	test.NoError(json.Unmarshal(data, &decoded))
+	test.Equal(mustRange("1.0", "2.0"), decoded)
+
+	test.Error(json.Unmarshal([]byte(`{"min":"2.0","max":"1.0"}`), &decoded))
+	test.Error(json.Unmarshal([]byte(`{"min":"abc"}`), &decoded))
+	test.Equal(mustRange("1.0", "2.0"), decoded)
+}
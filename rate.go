@@ -0,0 +1,229 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// Rate is exchange rate: Price is amount of Quote currency paid for single
+// unit of Base currency.
+//
+// Example:
+//	rate := decimal.Rate{Base: "BTC", Quote: "USD", Price: price}
+//	rate.Convert(amount, decimal.RoundHalfEven) // amount of USD
+type Rate struct {
+	Base  string
+	Quote string
+	Price Decimal
+
+	// Audit, if not nil, accumulates rounding of conversions at this rate,
+	// see RateAudit. Copies of rate share it.
+	Audit *RateAudit `json:"-"`
+}
+
+// RateAudit accumulates rounding applied by Rate.Convert() and
+// RateTable.Convert() at rates it's attached to, e.g. to bound slippage of
+// treasury conversions introduced by our own rounding. Totals are exact
+// amounts of converted currency. Zero value is empty audit.
+//
+// RateAudit is safe for concurrent use.
+//
+// Example:
+//	audit := new(decimal.RateAudit)
+//	rate := decimal.Rate{Base: "BTC", Quote: "USD", Price: price, Audit: audit}
+//	rate.Convert(amount, decimal.RoundHalfEven)
+//	audit.RoundedUp() // amount of USD added by rounding
+type RateAudit struct {
+	mutex sync.Mutex
+
+	conversions uint64
+	inexact     uint64
+	up          big.Rat
+	down        big.Rat
+}
+
+// record adds conversion which exact result numerator / denominator of
+// 0.00000001 was rounded to quotient. Audit may be nil.
+func (audit *RateAudit) record(numerator, denominator, quotient *big.Int) {
+	if audit == nil {
+		return
+	}
+
+	var difference big.Int
+	difference.Mul(quotient, denominator)
+	difference.Sub(&difference, numerator)
+
+	var amount big.Rat
+	amount.SetFrac(&difference, new(big.Int).Mul(denominator, bigFractional))
+
+	audit.mutex.Lock()
+	defer audit.mutex.Unlock()
+
+	audit.conversions++
+
+	switch amount.Sign() {
+	case 1:
+		audit.inexact++
+		audit.up.Add(&audit.up, &amount)
+	case -1:
+		audit.inexact++
+		audit.down.Sub(&audit.down, &amount)
+	}
+}
+
+// Conversions returns number of audited conversions.
+func (audit *RateAudit) Conversions() uint64 {
+	audit.mutex.Lock()
+	defer audit.mutex.Unlock()
+
+	return audit.conversions
+}
+
+// Inexact returns number of audited conversions which were rounded.
+func (audit *RateAudit) Inexact() uint64 {
+	audit.mutex.Lock()
+	defer audit.mutex.Unlock()
+
+	return audit.inexact
+}
+
+// RoundedUp returns total amount by which results of conversions exceed
+// their exact values.
+func (audit *RateAudit) RoundedUp() *big.Rat {
+	audit.mutex.Lock()
+	defer audit.mutex.Unlock()
+
+	return new(big.Rat).Set(&audit.up)
+}
+
+// RoundedDown returns total amount by which results of conversions are less
+// than their exact values.
+func (audit *RateAudit) RoundedDown() *big.Rat {
+	audit.mutex.Lock()
+	defer audit.mutex.Unlock()
+
+	return new(big.Rat).Set(&audit.down)
+}
+
+// Convert returns given amount of Base currency converted to Quote currency
+// and rounded to 8 places with given mode. Method will return error if
+// result can't be stored in Decimal type.
+func (rate Rate) Convert(amount Decimal, mode RoundingMode) (Decimal, error) {
+	exact := product(amount, rate.Price)
+	units := mode.apply("rate.convert", exact, bigFractional, 1)
+
+	result, ok := fromBig(units)
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold %s %s converted to %s at %s",
+			amount.String(),
+			rate.Base,
+			rate.Quote,
+			rate.Price.String(),
+		)
+	}
+
+	rate.Audit.record(exact, bigFractional, units)
+
+	return result, nil
+}
+
+// ratePair is key of RateTable.
+type ratePair struct {
+	base, quote string
+}
+
+// RateTable stores exchange rates by currency pair and converts amounts
+// with rounding mode of table. It's safe for concurrent use, so rates can
+// be updated while amounts are converted.
+//
+// Example:
+//	rates := decimal.NewRateTable(decimal.RoundHalfEven)
+//	rates.Set(decimal.Rate{Base: "BTC", Quote: "USD", Price: price})
+//	rates.Convert(amount, "USD", "BTC")
+type RateTable struct {
+	mode RoundingMode
+
+	mutex sync.RWMutex
+	rates map[ratePair]Rate
+}
+
+// NewRateTable returns empty RateTable which rounds converted amounts to 8
+// places with given mode. Pass DefaultPolicy().Rounding to apply house rule,
+// since table doesn't consult it.
+func NewRateTable(mode RoundingMode) *RateTable {
+	return &RateTable{mode: mode, rates: map[ratePair]Rate{}}
+}
+
+// Set stores given rate, replacing previous rate of the same pair. Method
+// will return error if price is zero or currencies are the same.
+func (table *RateTable) Set(rate Rate) error {
+	if rate.Price == 0 {
+		return fmt.Errorf("rate %s/%s should be positive", rate.Base, rate.Quote)
+	}
+
+	if rate.Base == rate.Quote {
+		return fmt.Errorf("rate %s/%s converts currency to itself", rate.Base, rate.Quote)
+	}
+
+	table.mutex.Lock()
+	defer table.mutex.Unlock()
+
+	table.rates[ratePair{rate.Base, rate.Quote}] = rate
+
+	return nil
+}
+
+// Rate returns stored rate of given pair and false if it's not stored.
+func (table *RateTable) Rate(base, quote string) (Rate, bool) {
+	table.mutex.RLock()
+	defer table.mutex.RUnlock()
+
+	rate, ok := table.rates[ratePair{base, quote}]
+
+	return rate, ok
+}
+
+// Convert returns given amount of currency from converted to currency to.
+// It uses rate of from/to pair or, if it's not stored, inverse of rate of
+// to/from pair, so single rate serves both directions; rates are not
+// chained through other currencies. Result is computed exactly and rounded
+// once with mode of table, amount is returned as is if currencies are the
+// same. Method will return error if neither pair is stored or result can't
+// be stored in Decimal type.
+func (table *RateTable) Convert(amount Decimal, from, to string) (Decimal, error) {
+	if from == to {
+		return amount, nil
+	}
+
+	if rate, ok := table.Rate(from, to); ok {
+		return rate.Convert(amount, table.mode)
+	}
+
+	rate, ok := table.Rate(to, from)
+	if !ok {
+		return 0, fmt.Errorf("rate table has no rate of %s/%s or %s/%s", from, to, to, from)
+	}
+
+	var numerator big.Int
+	numerator.Mul(bigDecimal(amount), bigFractional)
+
+	price := bigDecimal(rate.Price)
+	units := table.mode.apply("rate.convert", &numerator, price, 1)
+
+	result, ok := fromBig(units)
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold %s %s converted to %s at %s",
+			amount.String(),
+			from,
+			to,
+			rate.Price.String(),
+		)
+	}
+
+	rate.Audit.record(&numerator, price, units)
+
+	return result, nil
+}
@@ -0,0 +1,135 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRate_Convert_RoundsResult(t *testing.T) {
+	test := assert.New(t)
+
+	rate := Rate{Base: "BTC", Quote: "USD", Price: Must(FromString("9876.54321"))}
+
+	actual, err := rate.Convert(Must(FromString("0.00012345")), RoundHalfEven)
+	test.NoError(err)
+	test.Equal("1.21925926", actual.String())
+
+	actual, err = rate.Convert(Must(FromString("0.00012345")), RoundDown)
+	test.NoError(err)
+	test.Equal("1.21925925", actual.String())
+}
+
+func TestRate_Convert_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	rate := Rate{Base: "BTC", Quote: "USD", Price: Must(FromString("100000.0"))}
+
+	_, err := rate.Convert(Must(FromString("10000000.0")), RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "BTC converted to USD")
+}
+
+func TestRateTable_Convert(t *testing.T) {
+	test := assert.New(t)
+
+	rates := NewRateTable(RoundHalfEven)
+	test.NoError(rates.Set(Rate{Base: "BTC", Quote: "USD", Price: Must(FromString("30000.0"))}))
+
+	converted, err := rates.Convert(Must(FromString("0.5")), "BTC", "USD")
+	test.NoError(err)
+	test.Equal("15000.00000000", converted.String())
+
+	converted, err = rates.Convert(Must(FromString("100.0")), "USD", "BTC")
+	test.NoError(err)
+	test.Equal("0.00333333", converted.String())
+
+	converted, err = rates.Convert(Must(FromString("200.0")), "USD", "BTC")
+	test.NoError(err)
+	test.Equal("0.00666667", converted.String())
+
+	converted, err = rates.Convert(Must(FromString("1.5")), "EUR", "EUR")
+	test.NoError(err)
+	test.Equal(Must(FromString("1.5")), converted)
+
+	_, err = rates.Convert(Must(FromString("1.0")), "EUR", "USD")
+	test.Error(err)
+
+	_, err = rates.Convert(Must(FromString("99999999.0")), "BTC", "USD")
+	test.Error(err)
+}
+
+func TestRateTable_Set(t *testing.T) {
+	test := assert.New(t)
+
+	rates := NewRateTable(RoundDown)
+
+	test.Error(rates.Set(Rate{Base: "BTC", Quote: "USD"}))
+	test.Error(rates.Set(Rate{Base: "USD", Quote: "USD", Price: 1}))
+
+	test.NoError(rates.Set(Rate{Base: "BTC", Quote: "USD", Price: Must(FromString("30000.0"))}))
+	test.NoError(rates.Set(Rate{Base: "BTC", Quote: "USD", Price: Must(FromString("31000.0"))}))
+
+	rate, ok := rates.Rate("BTC", "USD")
+	test.True(ok)
+	test.Equal(Must(FromString("31000.0")), rate.Price)
+
+	// Direct rate is preferred over inverse one.
+	test.NoError(rates.Set(Rate{Base: "USD", Quote: "BTC", Price: Must(FromString("0.00003"))}))
+
+	converted, err := rates.Convert(Must(FromString("100.0")), "USD", "BTC")
+	test.NoError(err)
+	test.Equal("0.00300000", converted.String())
+
+	_, ok = rates.Rate("EUR", "USD")
+	test.False(ok)
+}
+
+func TestRateAudit_AccumulatesRounding(t *testing.T) {
+	test := assert.New(t)
+
+	audit := new(RateAudit)
+	rate := Rate{Base: "BTC", Quote: "USD", Price: Must(FromString("9876.54321")), Audit: audit}
+
+	_, err := rate.Convert(Must(FromString("0.00012345")), RoundHalfEven)
+	test.NoError(err)
+	_, err = rate.Convert(Must(FromString("0.00012345")), RoundDown)
+	test.NoError(err)
+	_, err = rate.Convert(Must(FromString("1.0")), RoundDown)
+	test.NoError(err)
+	_, err = rate.Convert(Decimal(Max-1), RoundDown)
+	test.Error(err)
+
+	test.Equal(uint64(3), audit.Conversions())
+	test.Equal(uint64(2), audit.Inexact())
+	test.Equal("1451/2000000000000", audit.RoundedUp().String())
+	test.Equal("18549/2000000000000", audit.RoundedDown().String())
+}
+
+func TestRateAudit_AuditsInverseConversions(t *testing.T) {
+	test := assert.New(t)
+
+	audit := new(RateAudit)
+
+	rates := NewRateTable(RoundHalfEven)
+	test.NoError(rates.Set(Rate{
+		Base:  "BTC",
+		Quote: "USD",
+		Price: Must(FromString("30000.0")),
+		Audit: audit,
+	}))
+
+	for _, amount := range []string{"100.0", "200.0", "300.0"} {
+		_, err := rates.Convert(Must(FromString(amount)), "USD", "BTC")
+		test.NoError(err)
+	}
+
+	test.Equal(uint64(3), audit.Conversions())
+	test.Equal(uint64(2), audit.Inexact())
+	test.Equal("1/300000000", audit.RoundedUp().String())
+	test.Equal("1/300000000", audit.RoundedDown().String())
+
+	var empty RateAudit
+	test.Equal(uint64(0), empty.Conversions())
+	test.Equal("0/1", empty.RoundedUp().String())
+}
@@ -0,0 +1,104 @@
+package decimal
+
+import (
+	"sort"
+)
+
+// Difference describes mismatch of single key found by Reconcile().
+type Difference struct {
+	Key string
+
+	// A and B contain values of both sides, missing values are zero.
+	A Decimal
+	B Decimal
+
+	// Delta is absolute difference between A and B.
+	Delta Decimal
+
+	// MissingA and MissingB report that key is absent on given side.
+	MissingA bool
+	MissingB bool
+}
+
+// Report contains result of Reconcile().
+type Report struct {
+	// Differences lists keys which are missing on one of sides or which
+	// values differ by more than tolerance, sorted by key.
+	Differences []Difference
+
+	// Matched is number of keys present on both sides with values within
+	// tolerance.
+	Matched int
+
+	// TotalA and TotalB contain sums of all values on each side.
+	TotalA Decimal
+	TotalB Decimal
+
+	// Overflow reports that TotalA or TotalB can't be stored in Decimal
+	// type; such total is saturated at largest value of Decimal type.
+	Overflow bool
+}
+
+// Balanced reports whether no differences were found.
+func (report Report) Balanced() bool {
+	return len(report.Differences) == 0
+}
+
+// Reconcile compares two sets of balances keyed by string and returns exact
+// per-key differences and totals of both sides. Keys present on both sides
+// are reported only if their values differ by more than tolerance; keys
+// missing on one of sides are always reported.
+func Reconcile(a, b map[string]Decimal, tolerance Decimal) Report {
+	var report Report
+
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		valueA, okA := a[key]
+		valueB, okB := b[key]
+
+		var ok bool
+
+		if report.TotalA, ok = add(report.TotalA, valueA); !ok {
+			report.TotalA = Decimal(Max - 1)
+			report.Overflow = true
+		}
+
+		if report.TotalB, ok = add(report.TotalB, valueB); !ok {
+			report.TotalB = Decimal(Max - 1)
+			report.Overflow = true
+		}
+
+		delta := valueA - valueB
+		if valueB > valueA {
+			delta = valueB - valueA
+		}
+
+		if okA && okB && delta <= tolerance {
+			report.Matched++
+			continue
+		}
+
+		report.Differences = append(report.Differences, Difference{
+			Key:      key,
+			A:        valueA,
+			B:        valueB,
+			Delta:    delta,
+			MissingA: !okA,
+			MissingB: !okB,
+		})
+	}
+
+	return report
+}
@@ -0,0 +1,73 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile_ReportsDifferencesAboveTolerance(t *testing.T) {
+	test := assert.New(t)
+
+	a := map[string]Decimal{
+		"alice": Must(FromString("1.5")),
+		"bob":   Must(FromString("2.0")),
+		"carol": Must(FromString("3.0")),
+	}
+	b := map[string]Decimal{
+		"alice": Must(FromString("1.50000001")),
+		"bob":   Must(FromString("2.1")),
+		"dave":  Must(FromString("0.0")),
+	}
+
+	report := Reconcile(a, b, Must(FromString("0.00000001")))
+	test.False(report.Balanced())
+	test.Equal(1, report.Matched)
+	test.Equal("6.50000000", report.TotalA.String())
+	test.Equal("3.60000001", report.TotalB.String())
+	test.False(report.Overflow)
+
+	test.Equal([]Difference{
+		{
+			Key:   "bob",
+			A:     Must(FromString("2.0")),
+			B:     Must(FromString("2.1")),
+			Delta: Must(FromString("0.1")),
+		},
+		{
+			Key:      "carol",
+			A:        Must(FromString("3.0")),
+			Delta:    Must(FromString("3.0")),
+			MissingB: true,
+		},
+		{
+			Key:      "dave",
+			MissingA: true,
+		},
+	}, report.Differences)
+}
+
+func TestReconcile_IsBalancedForEqualSides(t *testing.T) {
+	test := assert.New(t)
+
+	a := map[string]Decimal{"alice": Must(FromString("1.5"))}
+
+	report := Reconcile(a, a, 0)
+	test.True(report.Balanced())
+	test.Equal(1, report.Matched)
+}
+
+func TestReconcile_ReportsOverflowOfTotals(t *testing.T) {
+	test := assert.New(t)
+
+	a := map[string]Decimal{
+		"alice": Must(FromString("99999999999.0")),
+		"bob":   Must(FromString("1.0")),
+	}
+
+	report := Reconcile(a, a, 0)
+	test.True(report.Balanced())
+	test.True(report.Overflow)
+	test.Equal(Decimal(Max-1), report.TotalA)
+	test.Equal(Decimal(Max-1), report.TotalB)
+}
@@ -0,0 +1,157 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// TimedDecimal is value observed at given time, e.g. trade price with
+// traded volume.
+type TimedDecimal struct {
+	Time  time.Time
+	Value Decimal
+
+	// Volume is weight of value, used by AggregateVWAP.
+	Volume Decimal
+}
+
+// Aggregation defines how values of single bucket are combined by Resample().
+type Aggregation int
+
+const (
+	// AggregateSum sums values.
+	AggregateSum Aggregation = iota
+
+	// AggregateLast takes latest value.
+	AggregateLast
+
+	// AggregateMean takes arithmetic mean of values.
+	AggregateMean
+
+	// AggregateVWAP takes mean of values weighted by their volumes.
+	AggregateVWAP
+)
+
+// String returns name of aggregation.
+func (aggregation Aggregation) String() string {
+	switch aggregation {
+	case AggregateSum:
+		return "sum"
+	case AggregateLast:
+		return "last"
+	case AggregateMean:
+		return "mean"
+	case AggregateVWAP:
+		return "vwap"
+	default:
+		return fmt.Sprintf("Aggregation(%d)", int(aggregation))
+	}
+}
+
+// Resample groups points into buckets of given duration and returns single
+// aggregated point per non-empty bucket, ordered by time. Time of resulting
+// point is start of bucket and its Volume is total volume of bucket.
+//
+// Values are accumulated exactly; mean and VWAP are rounded once with
+// DefaultPolicy(). Function will return error if aggregated value can't be
+// stored in Decimal type or bucket has no volume for VWAP.
+func Resample(
+	points []TimedDecimal,
+	bucket time.Duration,
+	aggregation Aggregation,
+) ([]TimedDecimal, error) {
+	if bucket <= 0 {
+		return nil, fmt.Errorf("resample bucket should be positive: %s", bucket)
+	}
+
+	sorted := append([]TimedDecimal(nil), points...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	var result []TimedDecimal
+
+	for start := 0; start < len(sorted); {
+		at := sorted[start].Time.Truncate(bucket)
+
+		end := start
+		for end < len(sorted) && sorted[end].Time.Truncate(bucket).Equal(at) {
+			end++
+		}
+
+		point, err := aggregate(sorted[start:end], aggregation)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"resample can't aggregate bucket %s: %s",
+				at.Format(time.RFC3339),
+				err,
+			)
+		}
+
+		point.Time = at
+		result = append(result, point)
+
+		start = end
+	}
+
+	return result, nil
+}
+
+func aggregate(points []TimedDecimal, aggregation Aggregation) (TimedDecimal, error) {
+	var (
+		point    TimedDecimal
+		sum      big.Int
+		weighted big.Int
+		volume   big.Int
+	)
+
+	for _, item := range points {
+		sum.Add(&sum, new(big.Int).SetUint64(item.Value.Uint64()))
+		weighted.Add(&weighted, product(item.Value, item.Volume))
+		volume.Add(&volume, new(big.Int).SetUint64(item.Volume.Uint64()))
+	}
+
+	var (
+		value = &sum
+		ok    bool
+	)
+
+	switch aggregation {
+	case AggregateSum:
+
+	case AggregateLast:
+		value = new(big.Int).SetUint64(points[len(points)-1].Value.Uint64())
+
+	case AggregateMean:
+		value = DefaultPolicy().Rounding.apply(
+			"resample.mean",
+			&sum,
+			big.NewInt(int64(len(points))),
+			1,
+		)
+
+	case AggregateVWAP:
+		if volume.Sign() == 0 {
+			return point, fmt.Errorf("vwap needs non-zero volume")
+		}
+
+		value = DefaultPolicy().Rounding.apply("resample.vwap", &weighted, &volume, 1)
+
+	default:
+		return point, fmt.Errorf("unknown aggregation: %s", aggregation)
+	}
+
+	if point.Value, ok = fromBig(value); !ok {
+		return point, fmt.Errorf(
+			"decimal type can't hold %s of values", aggregation,
+		)
+	}
+
+	if point.Volume, ok = fromBig(&volume); !ok {
+		return point, fmt.Errorf("decimal type can't hold sum of volumes")
+	}
+
+	return point, nil
+}
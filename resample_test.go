@@ -0,0 +1,100 @@
+package decimal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testPoints() []TimedDecimal {
+	start := time.Date(2018, 7, 19, 12, 0, 0, 0, time.UTC)
+
+	point := func(offset time.Duration, value, volume string) TimedDecimal {
+		return TimedDecimal{
+			Time:   start.Add(offset),
+			Value:  Must(FromString(value)),
+			Volume: Must(FromString(volume)),
+		}
+	}
+
+	return []TimedDecimal{
+		point(30*time.Second, "101.0", "1.0"),
+		point(0, "100.0", "2.0"),
+		point(50*time.Second, "102.0", "1.0"),
+		point(2*time.Minute+10*time.Second, "99.0", "0.5"),
+	}
+}
+
+func TestResample_AggregatesBuckets(t *testing.T) {
+	test := assert.New(t)
+
+	start := time.Date(2018, 7, 19, 12, 0, 0, 0, time.UTC)
+
+	for aggregation, expected := range map[Aggregation][]string{
+		AggregateSum:  {"303.00000000", "99.00000000"},
+		AggregateLast: {"102.00000000", "99.00000000"},
+		AggregateMean: {"101.00000000", "99.00000000"},
+		AggregateVWAP: {"100.75000000", "99.00000000"},
+	} {
+		actual, err := Resample(testPoints(), time.Minute, aggregation)
+		test.NoError(err, aggregation.String())
+		test.Len(actual, 2, aggregation.String())
+
+		test.Equal(start, actual[0].Time)
+		test.Equal(expected[0], actual[0].Value.String(), aggregation.String())
+		test.Equal("4.00000000", actual[0].Volume.String())
+
+		test.Equal(start.Add(2*time.Minute), actual[1].Time)
+		test.Equal(expected[1], actual[1].Value.String(), aggregation.String())
+	}
+}
+
+func TestResample_RoundsMeanHalfEven(t *testing.T) {
+	test := assert.New(t)
+
+	now := time.Now()
+
+	actual, err := Resample([]TimedDecimal{
+		{Time: now, Value: 1},
+		{Time: now, Value: 2},
+	}, time.Hour, AggregateMean)
+	test.NoError(err)
+	test.Equal(Decimal(2), actual[0].Value)
+}
+
+func TestResample_SumDoesNotOverflowIntermediately(t *testing.T) {
+	test := assert.New(t)
+
+	now := time.Now()
+	max := Must(FromString("99999999999.0"))
+
+	actual, err := Resample([]TimedDecimal{
+		{Time: now, Value: max},
+		{Time: now, Value: max},
+	}, time.Hour, AggregateMean)
+	test.NoError(err)
+	test.Equal(max, actual[0].Value)
+
+	_, err = Resample([]TimedDecimal{
+		{Time: now, Value: max},
+		{Time: now, Value: max},
+	}, time.Hour, AggregateSum)
+	test.Error(err)
+	test.Contains(err.Error(), "can't hold sum")
+}
+
+func TestResample_ReturnsErrorOnMissingVolume(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Resample(
+		[]TimedDecimal{{Time: time.Now(), Value: 1}},
+		time.Hour,
+		AggregateVWAP,
+	)
+	test.Error(err)
+	test.Contains(err.Error(), "non-zero volume")
+
+	_, err = Resample(nil, 0, AggregateSum)
+	test.Error(err)
+}
@@ -0,0 +1,300 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// bigFractional is MaxFractional as big.Int, it must not be modified.
+var bigFractional = new(big.Int).SetUint64(MaxFractional)
+
+// RoundingMode specifies how result of operation is rounded when it can't be
+// stored in Decimal type exactly.
+type RoundingMode int
+
+const (
+	// RoundDown rounds towards zero, i.e. truncates extra digits.
+	RoundDown RoundingMode = iota
+
+	// RoundUp rounds away from zero.
+	RoundUp
+
+	// RoundHalfUp rounds to nearest value, ties are rounded away from zero.
+	RoundHalfUp
+
+	// RoundHalfDown rounds to nearest value, ties are rounded towards zero.
+	RoundHalfDown
+
+	// RoundHalfEven rounds to nearest value, ties are rounded to even
+	// value (banker's rounding).
+	RoundHalfEven
+)
+
+// String returns name of rounding mode.
+func (mode RoundingMode) String() string {
+	switch mode {
+	case RoundDown:
+		return "down"
+	case RoundUp:
+		return "up"
+	case RoundHalfUp:
+		return "half-up"
+	case RoundHalfDown:
+		return "half-down"
+	case RoundHalfEven:
+		return "half-even"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int(mode))
+	}
+}
+
+// increment reports whether truncated quotient should be incremented by one
+// when rounding, given doubled remainder compared against divisor and
+// parity of quotient.
+func (mode RoundingMode) increment(half int, odd, inexact bool) bool {
+	if !inexact {
+		return false
+	}
+
+	switch mode {
+	case RoundUp:
+		return true
+	case RoundHalfUp:
+		return half >= 0
+	case RoundHalfDown:
+		return half > 0
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && odd)
+	default:
+		return false
+	}
+}
+
+// divide returns numerator / denominator rounded with given mode. Both
+// arguments must be non-negative and denominator must be non-zero.
+func (mode RoundingMode) divide(numerator, denominator *big.Int) *big.Int {
+	var quotient, remainder big.Int
+	quotient.QuoRem(numerator, denominator, &remainder)
+
+	var doubled big.Int
+	doubled.Lsh(&remainder, 1)
+
+	if mode.increment(
+		doubled.Cmp(denominator),
+		quotient.Bit(0) == 1,
+		remainder.Sign() != 0,
+	) {
+		quotient.Add(&quotient, big.NewInt(1))
+	}
+
+	return &quotient
+}
+
+// fromBig returns given integer number of 0.00000001 as Decimal and false
+// if it can't be stored in Decimal type.
+func fromBig(units *big.Int) (Decimal, bool) {
+	if units.Sign() < 0 || !units.IsUint64() || units.Uint64() >= Max {
+		return 0, false
+	}
+
+	return Decimal(units.Uint64()), true
+}
+
+// add returns sum of given values and false if result can't be stored in
+// Decimal type.
+func add(a, b Decimal) (Decimal, bool) {
+	if uint64(b) >= Max || uint64(a) >= Max-uint64(b) {
+		return 0, false
+	}
+
+	return a + b, true
+}
+
+// product returns exact product of given values in units of 1e-16.
+func product(a, b Decimal) *big.Int {
+	var result big.Int
+	result.SetUint64(a.Uint64())
+	return result.Mul(&result, new(big.Int).SetUint64(b.Uint64()))
+}
+
+// bigDecimal returns value in units of 0.00000001 as big.Int.
+func bigDecimal(value Decimal) *big.Int {
+	return new(big.Int).SetUint64(value.Uint64())
+}
+
+// bigWords returns big.Int composed of given 64-bit words, most significant
+// first.
+func bigWords(words ...uint64) *big.Int {
+	var result, word big.Int
+	for _, value := range words {
+		result.Lsh(&result, 64)
+		result.Or(&result, word.SetUint64(value))
+	}
+
+	return &result
+}
+
+// sum128 returns exact sum of given values as 128-bit number hi:lo. Sum of
+// less than 2^64 values never overflows it.
+func sum128(values []Decimal) (hi, lo uint64) {
+	for _, value := range values {
+		hi, lo, _ = add128(hi, lo, 0, value.Uint64())
+	}
+
+	return hi, lo
+}
+
+// add128 returns sum of 128-bit numbers aHi:aLo and bHi:bLo and false if it
+// overflows 128 bits.
+func add128(aHi, aLo, bHi, bLo uint64) (hi, lo uint64, ok bool) {
+	lo, carry := bits.Add64(aLo, bLo, 0)
+	hi, carry = bits.Add64(aHi, bHi, carry)
+
+	return hi, lo, carry == 0
+}
+
+// fromUint128 returns 128-bit number hi:lo of 0.00000001 as Decimal and
+// false if it can't be stored in Decimal type.
+func fromUint128(hi, lo uint64) (Decimal, bool) {
+	if hi != 0 || lo >= Max {
+		return 0, false
+	}
+
+	return Decimal(lo), true
+}
+
+// powers contains powers of ten which fit into uint64.
+var powers = [...]uint64{
+	1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+}
+
+// Round returns value rounded to given number of digits after decimal
+// point with given mode. Method will return error if number of places is
+// not in range from 0 to 8 or rounded value can't be stored in Decimal type.
+//
+// Example:
+//	decimal.Scan("1.2345")
+//	decimal.Round(2, decimal.RoundHalfUp) // will return 1.23000000
+func (decimal Decimal) Round(places int, mode RoundingMode) (Decimal, error) {
+	rounded, err := decimal.round(places, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	if rounded > decimal {
+		reportInexact("round", rounded-decimal)
+	} else {
+		reportInexact("round", decimal-rounded)
+	}
+
+	return rounded, nil
+}
+
+// round is like Round() but doesn't report discarded part of value.
+func (decimal Decimal) round(places int, mode RoundingMode) (Decimal, error) {
+	if places < 0 || places > MaxPointsFractional {
+		return 0, fmt.Errorf(
+			"number of places should be from 0 to %d: %d",
+			MaxPointsFractional,
+			places,
+		)
+	}
+
+	factor := powers[MaxPointsFractional-places]
+
+	quotient := decimal.Uint64() / factor
+	remainder := decimal.Uint64() % factor
+
+	if mode.increment(
+		compare(2*remainder, factor),
+		quotient%2 == 1,
+		remainder != 0,
+	) {
+		quotient++
+	}
+
+	if quotient >= Max/factor {
+		return 0, fmt.Errorf(
+			"decimal type can't hold rounded value: %s",
+			decimal.String(),
+		)
+	}
+
+	return Decimal(quotient * factor), nil
+}
+
+// Exposure declares direction in which value affects exposure of the house,
+// see RoundConservative().
+type Exposure int
+
+const (
+	// ExposurePayable is value which house pays or delivers, e.g. quantity
+	// credited to user or price at which house buys. It's rounded down.
+	ExposurePayable Exposure = iota
+
+	// ExposureReceivable is value which house receives, e.g. fee or price
+	// at which house sells. It's rounded up.
+	ExposureReceivable
+)
+
+// String returns name of exposure direction.
+func (direction Exposure) String() string {
+	switch direction {
+	case ExposurePayable:
+		return "payable"
+	case ExposureReceivable:
+		return "receivable"
+	default:
+		return fmt.Sprintf("Exposure(%d)", int(direction))
+	}
+}
+
+// RoundConservative returns value rounded to given number of digits after
+// decimal point in direction which never increases exposure of the house:
+// payable values are rounded down and receivable values are rounded up.
+// Method will return error on unknown direction or if value can't be
+// rounded, see Round().
+//
+// Example:
+//	decimal.Scan("1.2345")
+//	decimal.RoundConservative(2, decimal.ExposurePayable) // will return 1.23
+//	decimal.RoundConservative(2, decimal.ExposureReceivable) // will return 1.24
+func (decimal Decimal) RoundConservative(places int, direction Exposure) (Decimal, error) {
+	switch direction {
+	case ExposurePayable:
+		return decimal.Round(places, RoundDown)
+	case ExposureReceivable:
+		return decimal.Round(places, RoundUp)
+	default:
+		return 0, fmt.Errorf("unknown exposure direction: %s", direction)
+	}
+}
+
+// Places returns number of significant digits after decimal point.
+//
+// Example:
+//	decimal.Scan("1.2300")
+//	decimal.Places() // will return 2
+func (decimal Decimal) Places() int {
+	fractional := decimal.Uint64() % MaxFractional
+
+	places := MaxPointsFractional
+	for ; places > 0 && fractional%10 == 0; places-- {
+		fractional /= 10
+	}
+
+	return places
+}
+
+func compare(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
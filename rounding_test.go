@@ -0,0 +1,120 @@
+package decimal
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundingMode_Divide_RoundsAccordingToMode(t *testing.T) {
+	test := assert.New(t)
+
+	divide := func(mode RoundingMode, numerator, denominator int64) int64 {
+		return mode.divide(
+			big.NewInt(numerator),
+			big.NewInt(denominator),
+		).Int64()
+	}
+
+	for _, example := range []struct {
+		mode     RoundingMode
+		expected [5]int64
+	}{
+		// 10/4, 14/4, 15/4, 12/4, 10/3
+		{RoundDown, [5]int64{2, 3, 3, 3, 3}},
+		{RoundUp, [5]int64{3, 4, 4, 3, 4}},
+		{RoundHalfUp, [5]int64{3, 4, 4, 3, 3}},
+		{RoundHalfDown, [5]int64{2, 3, 4, 3, 3}},
+		{RoundHalfEven, [5]int64{2, 4, 4, 3, 3}},
+	} {
+		test.Equal(example.expected, [5]int64{
+			divide(example.mode, 10, 4),
+			divide(example.mode, 14, 4),
+			divide(example.mode, 15, 4),
+			divide(example.mode, 12, 4),
+			divide(example.mode, 10, 3),
+		}, example.mode.String())
+	}
+}
+
+func TestRoundingMode_String_ReturnsName(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal("half-even", RoundHalfEven.String())
+	test.Equal("RoundingMode(42)", RoundingMode(42).String())
+}
+
+func TestDecimal_Round_RoundsToPlaces(t *testing.T) {
+	test := assert.New(t)
+
+	value := Must(FromString("1.235"))
+
+	for mode, expected := range map[RoundingMode]string{
+		RoundDown:     "1.23000000",
+		RoundUp:       "1.24000000",
+		RoundHalfUp:   "1.24000000",
+		RoundHalfDown: "1.23000000",
+		RoundHalfEven: "1.24000000",
+	} {
+		actual, err := value.Round(2, mode)
+		test.NoError(err)
+		test.Equal(expected, actual.String(), mode.String())
+	}
+
+	actual, err := Must(FromString("2.5")).Round(0, RoundHalfEven)
+	test.NoError(err)
+	test.Equal("2.00000000", actual.String())
+
+	actual, err = value.Round(8, RoundUp)
+	test.NoError(err)
+	test.Equal(value, actual)
+}
+
+func TestDecimal_Round_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Must(FromString("99999999999.5")).Round(0, RoundHalfUp)
+	test.Error(err)
+	test.Contains(err.Error(), "can't hold rounded value")
+
+	_, err = Decimal(0).Round(9, RoundDown)
+	test.Error(err)
+}
+
+func TestDecimal_RoundConservative_NeverIncreasesExposure(t *testing.T) {
+	test := assert.New(t)
+
+	value := Must(FromString("1.2345"))
+
+	payable, err := value.RoundConservative(2, ExposurePayable)
+	test.NoError(err)
+	test.Equal("1.23000000", payable.String())
+
+	receivable, err := value.RoundConservative(2, ExposureReceivable)
+	test.NoError(err)
+	test.Equal("1.24000000", receivable.String())
+
+	exact, err := Must(FromString("1.2")).RoundConservative(2, ExposureReceivable)
+	test.NoError(err)
+	test.Equal("1.20000000", exact.String())
+}
+
+func TestDecimal_RoundConservative_ReturnsErrorOnUnknownDirection(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Decimal(1).RoundConservative(2, Exposure(42))
+	test.Error(err)
+	test.Contains(err.Error(), "Exposure(42)")
+
+	_, err = Decimal(1).RoundConservative(9, ExposurePayable)
+	test.Error(err)
+}
+
+func TestDecimal_Places_CountsSignificantDigits(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal(0, Must(FromString("100.0")).Places())
+	test.Equal(2, Must(FromString("1.23")).Places())
+	test.Equal(8, Must(FromString("0.00000001")).Places())
+}
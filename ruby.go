@@ -0,0 +1,40 @@
+package decimal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RubyString returns representation produced by BigDecimal#to_s in Ruby:
+// significant digits after "0." followed by decimal exponent. Zero is
+// represented as "0.0".
+//
+// Example:
+//	decimal.Scan("12.3")
+//	decimal.RubyString() // will return "0.123e2"
+//	decimal.Scan("0.00000001")
+//	decimal.RubyString() // will return "0.1e-7"
+func (decimal Decimal) RubyString() string {
+	if decimal == 0 {
+		return "0.0"
+	}
+
+	digits := strconv.FormatUint(decimal.Uint64(), 10)
+	exponent := len(digits) - MaxPointsFractional
+
+	return "0." + strings.TrimRight(digits, "0") + "e" + strconv.Itoa(exponent)
+}
+
+// ParseRuby returns Decimal parsed from BigDecimal string representation
+// used in Ruby, e.g. "0.123e2", as well as plain notation like "12.3".
+// Negative values, NaN and Infinity are rejected.
+func ParseRuby(value string) (Decimal, error) {
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		return 0, fmt.Errorf(
+			"decimal type can't hold signed BigDecimal value: %q", value,
+		)
+	}
+
+	return parseScientific(value)
+}
@@ -0,0 +1,59 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_RubyString_MatchesBigDecimal(t *testing.T) {
+	test := assert.New(t)
+
+	for value, expected := range map[string]string{
+		"0.0":                  "0.0",
+		"1.0":                  "0.1e1",
+		"12.3":                 "0.123e2",
+		"0.05":                 "0.5e-1",
+		"100.0":                "0.1e3",
+		"0.00000001":           "0.1e-7",
+		"99999999999.99999999": "0.9999999999999999999e11",
+	} {
+		test.Equal(expected, Must(FromString(value)).RubyString(), value)
+	}
+}
+
+func TestParseRuby_RoundTrips(t *testing.T) {
+	test := assert.New(t)
+
+	for _, value := range []string{"0.0", "0.00000001", "0.5", "12345.6789"} {
+		expected := Must(FromString(value))
+
+		actual, err := ParseRuby(expected.RubyString())
+		test.NoError(err, value)
+		test.Equal(expected, actual, value)
+	}
+}
+
+func TestParseRuby_AcceptsPlainNotation(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := ParseRuby("12.3")
+	test.NoError(err)
+	test.Equal("12.30000000", actual.String())
+
+	actual, err = ParseRuby("5")
+	test.NoError(err)
+	test.Equal("5.00000000", actual.String())
+}
+
+func TestParseRuby_ReturnsErrorOnUnsupportedValues(t *testing.T) {
+	test := assert.New(t)
+
+	for _, value := range []string{
+		"-0.1e1", "NaN", "Infinity", "0.1e-8", "0.1e12", "0.1e", "",
+		"0.1e9223372036854775807", "0.111111111e-9223372036854775808",
+	} {
+		_, err := ParseRuby(value)
+		test.Error(err, value)
+	}
+}
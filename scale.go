@@ -0,0 +1,122 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Scale enforces maximum number of digits after decimal point, e.g. 0 for
+// JPY prices. Values which need more places are either rejected or rounded,
+// depending on configuration.
+//
+// Example:
+//	jpy := decimal.WithMaxScale(0)
+//	jpy.Parse("4000000.5") // returns error
+//	jpy.Rounding(decimal.RoundHalfUp).Parse("4000000.5") // 4000001
+type Scale struct {
+	places   int
+	rounding bool
+	mode     RoundingMode
+}
+
+// WithMaxScale returns Scale allowing at most given number of digits after
+// decimal point and returning error for values which need more. It panics
+// if number of places is not in range from 0 to 8.
+func WithMaxScale(places int) Scale {
+	if places < 0 || places > MaxPointsFractional {
+		panic(fmt.Sprintf(
+			"number of places should be from 0 to %d: %d",
+			MaxPointsFractional,
+			places,
+		))
+	}
+
+	return Scale{places: places}
+}
+
+// Rounding returns copy of Scale which rounds values with given mode instead
+// of returning error.
+func (scale Scale) Rounding(mode RoundingMode) Scale {
+	scale.rounding = true
+	scale.mode = mode
+	return scale
+}
+
+// Places returns maximum number of digits after decimal point.
+func (scale Scale) Places() int {
+	return scale.places
+}
+
+// Apply returns given value if it fits into scale. Otherwise it returns
+// rounded value or error, depending on configuration.
+func (scale Scale) Apply(value Decimal) (Decimal, error) {
+	if value.Places() <= scale.places {
+		return value, nil
+	}
+
+	if !scale.rounding {
+		return 0, fmt.Errorf(
+			"decimal value has more than %d places: %s",
+			scale.places,
+			value.String(),
+		)
+	}
+
+	return value.Round(scale.places, scale.mode)
+}
+
+// Parse returns Decimal parsed from string and constrained by Apply().
+func (scale Scale) Parse(value string) (Decimal, error) {
+	number, err := FromString(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return scale.Apply(number)
+}
+
+// Multiply returns product of given values constrained by Apply(). Unlike
+// Decimal.Multiply(), rounding scale also rounds away digits beyond 8th
+// place instead of returning error.
+func (scale Scale) Multiply(a, b Decimal) (Decimal, error) {
+	return scale.multiply(a, b, true)
+}
+
+// multiply is like Multiply() but reports discarded part of value as inexact
+// operation only if report is true.
+func (scale Scale) multiply(a, b Decimal, report bool) (Decimal, error) {
+	if !scale.rounding {
+		product, err := a.Multiply(b)
+		if err != nil {
+			return 0, err
+		}
+
+		return scale.Apply(product)
+	}
+
+	var factor big.Int
+	factor.SetUint64(powers[2*MaxPointsFractional-scale.places])
+
+	unit := powers[MaxPointsFractional-scale.places]
+
+	var units *big.Int
+	if report {
+		units = scale.mode.apply("scale.multiply", product(a, b), &factor, unit)
+	} else {
+		units = scale.mode.divide(product(a, b), &factor)
+	}
+
+	units.Mul(units, new(big.Int).SetUint64(unit))
+
+	result, ok := fromBig(units)
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold integer part of multiplication: "+
+				"%s × %s",
+			a.String(),
+			b.String(),
+		)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,61 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScale_Parse_ReturnsErrorOnExtraPlaces(t *testing.T) {
+	test := assert.New(t)
+
+	jpy := WithMaxScale(0)
+
+	actual, err := jpy.Parse("4000000.0")
+	test.NoError(err)
+	test.Equal("4000000.00000000", actual.String())
+
+	_, err = jpy.Parse("4000000.5")
+	test.Error(err)
+	test.Contains(err.Error(), "more than 0 places")
+}
+
+func TestScale_Parse_RoundsExtraPlaces(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := WithMaxScale(0).Rounding(RoundHalfUp).Parse("4000000.5")
+	test.NoError(err)
+	test.Equal("4000001.00000000", actual.String())
+}
+
+func TestScale_Multiply_RoundsBeyondEightPlaces(t *testing.T) {
+	test := assert.New(t)
+
+	scale := WithMaxScale(2).Rounding(RoundHalfEven)
+
+	actual, err := scale.Multiply(
+		Must(FromString("1.99999999")),
+		Must(FromString("1.01")),
+	)
+	test.NoError(err)
+	test.Equal("2.02000000", actual.String())
+
+	_, err = WithMaxScale(2).Multiply(
+		Must(FromString("1.99999999")),
+		Must(FromString("1.01")),
+	)
+	test.Error(err)
+
+	_, err = scale.Multiply(
+		Must(FromString("99999999999.0")),
+		Must(FromString("1.1")),
+	)
+	test.Error(err)
+}
+
+func TestWithMaxScale_PanicsOnInvalidPlaces(t *testing.T) {
+	test := assert.New(t)
+
+	test.Panics(func() { WithMaxScale(9) })
+	test.Panics(func() { WithMaxScale(-1) })
+}
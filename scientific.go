@@ -0,0 +1,88 @@
+package decimal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseScientific parses non-negative number in plain or scientific
+// notation, e.g. "1.5", ".5", "5.", "15E-1" or "0.15e+1", and returns error
+// if value can't be stored in Decimal type without loosing precision.
+func parseScientific(value string) (Decimal, error) {
+	mantissa := value
+	exponent := 0
+
+	if i := strings.IndexAny(value, "eE"); i >= 0 {
+		var err error
+
+		mantissa = value[:i]
+		exponent, err = strconv.Atoi(value[i+1:])
+		if err != nil {
+			return 0, fmt.Errorf(
+				"decimal type can't parse exponent of value: %q", value,
+			)
+		}
+	}
+
+	// Value with greater exponent has more digits than Decimal type holds
+	// even after trailing zeros of mantissa are removed, and bounding it
+	// keeps arithmetic below from overflowing.
+	if limit := MaxPoints + len(mantissa); exponent > limit {
+		return 0, fmt.Errorf(
+			"decimal type can't hold integer part of value: %q", value,
+		)
+	} else if exponent < -limit {
+		return 0, fmt.Errorf(
+			"decimal type can't hold fractional part of value: %q", value,
+		)
+	}
+
+	integer, fraction := mantissa, ""
+	if period := strings.IndexByte(mantissa, '.'); period >= 0 {
+		integer, fraction = mantissa[:period], mantissa[period+1:]
+	}
+
+	digits := integer + fraction
+	if digits == "" || strings.TrimLeft(digits, "0123456789") != "" {
+		return 0, fmt.Errorf(
+			"decimal type can't be parsed from value: %q", value,
+		)
+	}
+
+	exponent -= len(fraction)
+
+	digits = strings.TrimLeft(digits, "0")
+	for strings.HasSuffix(digits, "0") {
+		digits = digits[:len(digits)-1]
+		exponent++
+	}
+
+	if digits == "" {
+		return 0, nil
+	}
+
+	shift := exponent + MaxPointsFractional
+	if shift < 0 {
+		return 0, fmt.Errorf(
+			"decimal type can't hold fractional part of value: %q", value,
+		)
+	}
+
+	if len(digits)+shift > MaxPoints {
+		return 0, fmt.Errorf(
+			"decimal type can't hold integer part of value: %q", value,
+		)
+	}
+
+	units, err := strconv.ParseUint(
+		digits+strings.Repeat("0", shift), 10, 64,
+	)
+	if err != nil || units >= Max {
+		return 0, fmt.Errorf(
+			"decimal type can't hold integer part of value: %q", value,
+		)
+	}
+
+	return Decimal(units), nil
+}
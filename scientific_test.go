@@ -0,0 +1,37 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScientific_RejectsExtremeExponents(t *testing.T) {
+	test := assert.New(t)
+
+	for _, example := range []struct {
+		value    string
+		expected string
+	}{
+		{"1e9223372036854775807", "integer part"},
+		{"0.111111111e9223372036854775807", "integer part"},
+		{"1e-9223372036854775808", "fractional part"},
+		{"0.111111111e-9223372036854775808", "fractional part"},
+		{"1e99999999999999999999", "exponent"},
+		{"1e30", "integer part"},
+		{"1e-30", "fractional part"},
+	} {
+		_, err := parseScientific(example.value)
+		if test.Error(err, example.value) {
+			test.Contains(err.Error(), example.expected, example.value)
+		}
+	}
+
+	actual, err := parseScientific("1000000000000000000000000000000e-30")
+	test.NoError(err)
+	test.Equal("1.00000000", actual.String())
+
+	actual, err = parseScientific("0.00000000000000000000000000001e29")
+	test.NoError(err)
+	test.Equal("1.00000000", actual.String())
+}
@@ -0,0 +1,40 @@
+package decimal
+
+import (
+	"sort"
+)
+
+// SearchDecimals searches for target in slice sorted in ascending order and
+// returns its index and true if it's present. Otherwise it returns index at
+// which target should be inserted to keep slice sorted and false.
+func SearchDecimals(sorted []Decimal, target Decimal) (int, bool) {
+	index := sort.Search(len(sorted), func(i int) bool {
+		return sorted[i] >= target
+	})
+
+	return index, index < len(sorted) && sorted[index] == target
+}
+
+// SearchFloor returns index of greatest value in ascending slice which is
+// less than or equal to target, e.g. fee tier which applies to given
+// volume. It returns false if all values are greater than target.
+func SearchFloor(sorted []Decimal, target Decimal) (int, bool) {
+	index := sort.Search(len(sorted), func(i int) bool {
+		return sorted[i] > target
+	})
+
+	return index - 1, index > 0
+}
+
+// SearchCeiling returns index of smallest value in ascending slice which is
+// greater than or equal to target, e.g. tick table entry at or above given
+// price. It returns false if all values are less than target.
+func SearchCeiling(sorted []Decimal, target Decimal) (int, bool) {
+	index, _ := SearchDecimals(sorted, target)
+
+	if index == len(sorted) {
+		return -1, false
+	}
+
+	return index, true
+}
@@ -0,0 +1,73 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testTiers() []Decimal {
+	return []Decimal{
+		Must(FromString("0.0")),
+		Must(FromString("10.0")),
+		Must(FromString("100.0")),
+	}
+}
+
+func TestSearchDecimals_FindsValue(t *testing.T) {
+	test := assert.New(t)
+
+	index, found := SearchDecimals(testTiers(), Must(FromString("10.0")))
+	test.Equal(1, index)
+	test.True(found)
+
+	index, found = SearchDecimals(testTiers(), Must(FromString("50.0")))
+	test.Equal(2, index)
+	test.False(found)
+
+	index, found = SearchDecimals(testTiers(), Must(FromString("500.0")))
+	test.Equal(3, index)
+	test.False(found)
+
+	index, found = SearchDecimals(nil, 0)
+	test.Equal(0, index)
+	test.False(found)
+}
+
+func TestSearchFloor_ReturnsGreatestLowerOrEqual(t *testing.T) {
+	test := assert.New(t)
+
+	for value, expected := range map[string]int{
+		"0.0":    0,
+		"9.9":    0,
+		"10.0":   1,
+		"99.0":   1,
+		"1000.0": 2,
+	} {
+		index, ok := SearchFloor(testTiers(), Must(FromString(value)))
+		test.True(ok, value)
+		test.Equal(expected, index, value)
+	}
+
+	_, ok := SearchFloor(testTiers()[1:], Must(FromString("9.0")))
+	test.False(ok)
+}
+
+func TestSearchCeiling_ReturnsSmallestGreaterOrEqual(t *testing.T) {
+	test := assert.New(t)
+
+	for value, expected := range map[string]int{
+		"0.0":   0,
+		"9.9":   1,
+		"10.0":  1,
+		"100.0": 2,
+	} {
+		index, ok := SearchCeiling(testTiers(), Must(FromString(value)))
+		test.True(ok, value)
+		test.Equal(expected, index, value)
+	}
+
+	index, ok := SearchCeiling(testTiers(), Must(FromString("100.00000001")))
+	test.False(ok)
+	test.Equal(-1, index)
+}
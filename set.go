@@ -0,0 +1,237 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// Set is set of distinct Decimal values.
+type Set map[Decimal]struct{}
+
+// NewSet returns Set containing given values.
+func NewSet(values ...Decimal) Set {
+	set := make(Set, len(values))
+	for _, value := range values {
+		set.Add(value)
+	}
+
+	return set
+}
+
+// Add adds value to set.
+func (set Set) Add(value Decimal) {
+	set[value] = struct{}{}
+}
+
+// Remove removes value from set.
+func (set Set) Remove(value Decimal) {
+	delete(set, value)
+}
+
+// Contains reports whether value is in set.
+func (set Set) Contains(value Decimal) bool {
+	_, ok := set[value]
+	return ok
+}
+
+// Union returns new Set of values present in any of sets.
+func (set Set) Union(other Set) Set {
+	result := make(Set, len(set)+len(other))
+	for value := range set {
+		result.Add(value)
+	}
+
+	for value := range other {
+		result.Add(value)
+	}
+
+	return result
+}
+
+// Intersection returns new Set of values present in both sets.
+func (set Set) Intersection(other Set) Set {
+	result := Set{}
+	for value := range set {
+		if other.Contains(value) {
+			result.Add(value)
+		}
+	}
+
+	return result
+}
+
+// Difference returns new Set of values present in set but not in other.
+func (set Set) Difference(other Set) Set {
+	result := Set{}
+	for value := range set {
+		if !other.Contains(value) {
+			result.Add(value)
+		}
+	}
+
+	return result
+}
+
+// Values returns values of set in ascending order.
+func (set Set) Values() []Decimal {
+	values := make([]Decimal, 0, len(set))
+	for value := range set {
+		values = append(values, value)
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	return values
+}
+
+// Sum returns exact sum of values and error if it can't be stored in Decimal
+// type.
+func (set Set) Sum() (Decimal, error) {
+	counts := make(MultiSet, len(set))
+	for value := range set {
+		counts[value] = 1
+	}
+
+	return counts.Sum()
+}
+
+// MultiSet is set of Decimal values where each value can be present
+// multiple times. It maps value to number of its occurrences.
+type MultiSet map[Decimal]int
+
+// NewMultiSet returns MultiSet containing given values.
+func NewMultiSet(values ...Decimal) MultiSet {
+	set := MultiSet{}
+	for _, value := range values {
+		set.Add(value)
+	}
+
+	return set
+}
+
+// Add adds single occurrence of value.
+func (set MultiSet) Add(value Decimal) {
+	set[value]++
+}
+
+// Remove removes single occurrence of value and reports whether value was
+// present.
+func (set MultiSet) Remove(value Decimal) bool {
+	count, ok := set[value]
+	if !ok {
+		return false
+	}
+
+	if count <= 1 {
+		delete(set, value)
+	} else {
+		set[value] = count - 1
+	}
+
+	return true
+}
+
+// Count returns number of occurrences of value.
+func (set MultiSet) Count(value Decimal) int {
+	return set[value]
+}
+
+// Len returns total number of occurrences of all values.
+func (set MultiSet) Len() int {
+	var total int
+	for _, count := range set {
+		total += count
+	}
+
+	return total
+}
+
+// Union returns new MultiSet where every value occurs as many times as in
+// set where it occurs most.
+func (set MultiSet) Union(other MultiSet) MultiSet {
+	result := MultiSet{}
+	for value, count := range set {
+		result[value] = count
+	}
+
+	for value, count := range other {
+		if count > result[value] {
+			result[value] = count
+		}
+	}
+
+	return result
+}
+
+// Intersection returns new MultiSet where every value occurs as many times
+// as in set where it occurs least.
+func (set MultiSet) Intersection(other MultiSet) MultiSet {
+	result := MultiSet{}
+	for value, count := range set {
+		if other[value] < count {
+			count = other[value]
+		}
+
+		if count > 0 {
+			result[value] = count
+		}
+	}
+
+	return result
+}
+
+// Difference returns new MultiSet of occurrences which are present in set
+// but not matched by occurrences in other, e.g. expected payouts which were
+// not made.
+func (set MultiSet) Difference(other MultiSet) MultiSet {
+	result := MultiSet{}
+	for value, count := range set {
+		if count > other[value] {
+			result[value] = count - other[value]
+		}
+	}
+
+	return result
+}
+
+// Equal reports whether both sets contain the same values the same number
+// of times.
+func (set MultiSet) Equal(other MultiSet) bool {
+	return len(set.Difference(other)) == 0 && len(other.Difference(set)) == 0
+}
+
+// Values returns all occurrences of values in ascending order.
+func (set MultiSet) Values() []Decimal {
+	values := make([]Decimal, 0, set.Len())
+	for value, count := range set {
+		for i := 0; i < count; i++ {
+			values = append(values, value)
+		}
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	return values
+}
+
+// Sum returns exact sum of all occurrences of values and error if it can't
+// be stored in Decimal type.
+func (set MultiSet) Sum() (Decimal, error) {
+	var total big.Int
+
+	for value, count := range set {
+		var item big.Int
+		item.SetUint64(value.Uint64())
+		item.Mul(&item, big.NewInt(int64(count)))
+
+		total.Add(&total, &item)
+	}
+
+	sum, ok := fromBig(&total)
+	if !ok {
+		return 0, fmt.Errorf("decimal type can't hold sum of set values")
+	}
+
+	return sum, nil
+}
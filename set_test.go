@@ -0,0 +1,84 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet_Operations(t *testing.T) {
+	test := assert.New(t)
+
+	a := NewSet(1, 2, 3, 3)
+	b := NewSet(3, 4)
+
+	test.Len(a, 3)
+	test.True(a.Contains(3))
+	test.False(a.Contains(4))
+
+	test.Equal([]Decimal{1, 2, 3, 4}, a.Union(b).Values())
+	test.Equal([]Decimal{3}, a.Intersection(b).Values())
+	test.Equal([]Decimal{1, 2}, a.Difference(b).Values())
+
+	a.Remove(1)
+	test.Equal([]Decimal{2, 3}, a.Values())
+
+	sum, err := a.Sum()
+	test.NoError(err)
+	test.Equal(Decimal(5), sum)
+}
+
+func TestMultiSet_KeepsDuplicates(t *testing.T) {
+	test := assert.New(t)
+
+	expected := NewMultiSet(
+		Must(FromString("1.5")),
+		Must(FromString("1.5")),
+		Must(FromString("2.0")),
+	)
+	actual := NewMultiSet(
+		Must(FromString("1.5")),
+		Must(FromString("2.0")),
+		Must(FromString("3.0")),
+	)
+
+	test.Equal(3, expected.Len())
+	test.Equal(2, expected.Count(Must(FromString("1.5"))))
+	test.False(expected.Equal(actual))
+
+	test.Equal(
+		[]Decimal{Must(FromString("1.5"))},
+		expected.Difference(actual).Values(),
+	)
+	test.Equal(
+		[]Decimal{Must(FromString("3.0"))},
+		actual.Difference(expected).Values(),
+	)
+	test.Equal(
+		[]Decimal{Must(FromString("1.5")), Must(FromString("2.0"))},
+		expected.Intersection(actual).Values(),
+	)
+	test.Equal(4, expected.Union(actual).Len())
+
+	test.True(expected.Remove(Must(FromString("1.5"))))
+	test.False(expected.Remove(Must(FromString("9.0"))))
+	test.False(expected.Remove(Must(FromString("3.0"))))
+	test.True(expected.Union(NewMultiSet(Must(FromString("3.0")))).Equal(actual))
+}
+
+func TestMultiSet_Sum_CountsOccurrences(t *testing.T) {
+	test := assert.New(t)
+
+	sum, err := NewMultiSet(
+		Must(FromString("1.5")),
+		Must(FromString("1.5")),
+		Must(FromString("0.00000001")),
+	).Sum()
+	test.NoError(err)
+	test.Equal("3.00000001", sum.String())
+
+	max := Must(FromString("99999999999.0"))
+
+	_, err = NewMultiSet(max, max).Sum()
+	test.Error(err)
+}
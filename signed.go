@@ -0,0 +1,232 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Signed represents DECIMAL(19, 8) type, i.e. Decimal which can also hold
+// negative values, e.g. PnL, funding payments or balance deltas. It stores
+// magnitude as Decimal and separate sign, so it holds values from
+// -99999999999.99999999 to 99999999999.99999999. Zero is never negative, so
+// Signed values can be compared with ==.
+//
+// Rounding modes are applied to magnitude, so RoundDown rounds towards zero
+// and RoundUp away from zero for negative values as well.
+type Signed struct {
+	magnitude Decimal
+	negative  bool
+}
+
+// NewSigned returns Signed with given magnitude, negated if negative is
+// true.
+//
+// Example:
+//	decimal.NewSigned(loss, true) // will return -1.5 for loss 1.5
+func NewSigned(magnitude Decimal, negative bool) Signed {
+	return Signed{magnitude: magnitude, negative: negative && magnitude != 0}
+}
+
+// ParseSigned returns Signed parsed from string input, which is Decimal
+// representation with optional leading '-'.
+func ParseSigned(value string) (Signed, error) {
+	var number Signed
+	err := number.Scan(value)
+	return number, err
+}
+
+// Scan parses value from given string/bytes representation and return error
+// if value can't be stored in Signed type.
+// Used in SQL communication.
+func (signed *Signed) Scan(data interface{}) error {
+	switch data := data.(type) {
+	case []byte:
+		return signed.Scan(string(data))
+
+	case string:
+		var negative bool
+		if len(data) > 0 && data[0] == '-' {
+			negative = true
+			data = data[1:]
+		}
+
+		var magnitude Decimal
+		if err := magnitude.Scan(data); err != nil {
+			return err
+		}
+
+		*signed = NewSigned(magnitude, negative)
+
+		return nil
+
+	default:
+		return fmt.Errorf(
+			"signed decimal type expected to be []byte, but %T received",
+			data,
+		)
+	}
+}
+
+// Value returns string representation of Signed type.
+// Used in SQL communication.
+func (signed Signed) Value() (driver.Value, error) {
+	return signed.String(), nil
+}
+
+// String returns string representation of Signed type with '-' prefix for
+// negative values.
+//
+// Example:
+//	decimal.NewSigned(decimal.Lit(1_50000000), true).String() // will return "-1.50000000"
+func (signed Signed) String() string {
+	if signed.negative {
+		return "-" + signed.magnitude.String()
+	}
+
+	return signed.magnitude.String()
+}
+
+// MarshalText returns string representation as []byte type.
+// Used in json marshaling/unmarshaling.
+func (signed Signed) MarshalText() ([]byte, error) {
+	return []byte(signed.String()), nil
+}
+
+// UnmarshalText calls Scan() method to read Signed type.
+// Used in json marshaling/unmarshaling.
+func (signed *Signed) UnmarshalText(data []byte) error {
+	return signed.Scan(string(data))
+}
+
+// Abs returns magnitude of value.
+func (signed Signed) Abs() Decimal {
+	return signed.magnitude
+}
+
+// Negative reports whether value is less than zero.
+func (signed Signed) Negative() bool {
+	return signed.negative
+}
+
+// Sign returns -1, 0 or 1 if value is negative, zero or positive.
+func (signed Signed) Sign() int {
+	switch {
+	case signed.negative:
+		return -1
+	case signed.magnitude == 0:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Neg returns value with opposite sign.
+func (signed Signed) Neg() Signed {
+	return NewSigned(signed.magnitude, !signed.negative)
+}
+
+// Unsigned returns value as Decimal or error if it's negative.
+func (signed Signed) Unsigned() (Decimal, error) {
+	if signed.negative {
+		return 0, fmt.Errorf(
+			"decimal type can't hold negative value: %s", signed.String(),
+		)
+	}
+
+	return signed.magnitude, nil
+}
+
+// Cmp returns -1, 0 or 1 if current value is less than, equal to or greater
+// than other value.
+func (signed Signed) Cmp(other Signed) int {
+	switch {
+	case signed.negative != other.negative:
+		if signed.negative {
+			return -1
+		}
+
+		return 1
+
+	case signed.negative:
+		return compare(other.magnitude.Uint64(), signed.magnitude.Uint64())
+
+	default:
+		return compare(signed.magnitude.Uint64(), other.magnitude.Uint64())
+	}
+}
+
+// Add returns sum of current value and given addend. Method will return
+// error if magnitude of result exceeds maximum value of Decimal type.
+func (signed Signed) Add(addend Signed) (Signed, error) {
+	if signed.negative == addend.negative {
+		magnitude, ok := add(signed.magnitude, addend.magnitude)
+		if !ok {
+			return Signed{}, fmt.Errorf(
+				"signed decimal type can't hold result of addition: %s + %s",
+				signed.String(),
+				addend.String(),
+			)
+		}
+
+		return NewSigned(magnitude, signed.negative), nil
+	}
+
+	if signed.magnitude >= addend.magnitude {
+		return NewSigned(signed.magnitude-addend.magnitude, signed.negative), nil
+	}
+
+	return NewSigned(addend.magnitude-signed.magnitude, addend.negative), nil
+}
+
+// Sub returns result of subtracting given subtrahend from current value.
+// Method will return error if magnitude of result exceeds maximum value of
+// Decimal type.
+func (signed Signed) Sub(subtrahend Signed) (Signed, error) {
+	return signed.Add(subtrahend.Neg())
+}
+
+// Multiply returns result of multiplying current value with given
+// multiplier. Method will return error if result can't be stored in Signed
+// type without loosing precision, see Decimal.Multiply().
+func (signed Signed) Multiply(multiplier Signed) (Signed, error) {
+	magnitude, err := signed.magnitude.Multiply(multiplier.magnitude)
+	if err != nil {
+		return Signed{}, err
+	}
+
+	return NewSigned(magnitude, signed.negative != multiplier.negative), nil
+}
+
+// MultiplyRound returns result of multiplying current value with given
+// multiplier rounded to 8 places with given mode, see
+// Decimal.MultiplyRound().
+func (signed Signed) MultiplyRound(multiplier Signed, mode RoundingMode) (Signed, error) {
+	magnitude, err := signed.magnitude.MultiplyRound(multiplier.magnitude, mode)
+	if err != nil {
+		return Signed{}, err
+	}
+
+	return NewSigned(magnitude, signed.negative != multiplier.negative), nil
+}
+
+// Div returns result of dividing current value by given divisor rounded
+// with given mode, see Decimal.Div().
+func (signed Signed) Div(divisor Signed, mode RoundingMode) (Signed, error) {
+	magnitude, err := signed.magnitude.Div(divisor.magnitude, mode)
+	if err != nil {
+		return Signed{}, err
+	}
+
+	return NewSigned(magnitude, signed.negative != divisor.negative), nil
+}
+
+// Round returns value rounded to given number of digits after decimal
+// point with given mode, see Decimal.Round().
+func (signed Signed) Round(places int, mode RoundingMode) (Signed, error) {
+	magnitude, err := signed.magnitude.Round(places, mode)
+	if err != nil {
+		return Signed{}, err
+	}
+
+	return NewSigned(magnitude, signed.negative), nil
+}
@@ -0,0 +1,163 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustSigned(value string) Signed {
+	signed, err := ParseSigned(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return signed
+}
+
+func TestParseSigned_ParsesSign(t *testing.T) {
+	test := assert.New(t)
+
+	for value, expected := range map[string]string{
+		"1.5":                   "1.50000000",
+		"-1.5":                  "-1.50000000",
+		"-0.0":                  "0.00000000",
+		"-99999999999.99999999": "-99999999999.99999999",
+	} {
+		actual, err := ParseSigned(value)
+		test.NoError(err, value)
+		test.Equal(expected, actual.String(), value)
+	}
+
+	for _, value := range []string{"", "-", "--1.0", "+1.0", "-+1.0", "1", "-100000000000.0"} {
+		_, err := ParseSigned(value)
+		test.Error(err, value)
+	}
+
+	test.Equal(mustSigned("0.0"), mustSigned("-0.0"))
+	test.Equal(Signed{}, NewSigned(0, true))
+}
+
+func TestSigned_SQL(t *testing.T) {
+	test := assert.New(t)
+
+	var signed Signed
+	test.NoError(signed.Scan([]byte("-12.34000000")))
+	test.Equal(mustSigned("-12.34"), signed)
+
+	value, err := signed.Value()
+	test.NoError(err)
+	test.Equal("-12.34000000", value)
+
+	test.Error(signed.Scan(42))
+}
+
+func TestSigned_JSON(t *testing.T) {
+	test := assert.New(t)
+
+	data, err := json.Marshal(map[string]Signed{"pnl": mustSigned("-1.5")})
+	test.NoError(err)
+	test.Equal(`{"pnl":"-1.50000000"}`, string(data))
+
+	var decoded map[string]Signed
+	test.NoError(json.Unmarshal(data, &decoded))
+	test.Equal(mustSigned("-1.5"), decoded["pnl"])
+}
+
+func TestSigned_Sign(t *testing.T) {
+	test := assert.New(t)
+
+	test.Equal(-1, mustSigned("-1.0").Sign())
+	test.Equal(0, mustSigned("0.0").Sign())
+	test.Equal(1, mustSigned("1.0").Sign())
+
+	test.True(mustSigned("-1.0").Negative())
+	test.Equal(Must(FromString("1.0")), mustSigned("-1.0").Abs())
+	test.Equal(mustSigned("1.0"), mustSigned("-1.0").Neg())
+	test.Equal(Signed{}, Signed{}.Neg())
+
+	unsigned, err := mustSigned("1.0").Unsigned()
+	test.NoError(err)
+	test.Equal(Must(FromString("1.0")), unsigned)
+
+	_, err = mustSigned("-1.0").Unsigned()
+	test.Error(err)
+}
+
+func TestSigned_Cmp(t *testing.T) {
+	test := assert.New(t)
+
+	values := []Signed{
+		mustSigned("-2.0"),
+		mustSigned("-1.0"),
+		mustSigned("0.0"),
+		mustSigned("1.0"),
+		mustSigned("2.0"),
+	}
+
+	for i := range values {
+		for j := range values {
+			test.Equal(compare(uint64(i), uint64(j)), values[i].Cmp(values[j]))
+		}
+	}
+}
+
+func TestSigned_Add(t *testing.T) {
+	test := assert.New(t)
+
+	for _, c := range [][3]string{
+		{"1.5", "2.5", "4.0"},
+		{"-1.5", "-2.5", "-4.0"},
+		{"1.5", "-2.5", "-1.0"},
+		{"-1.5", "2.5", "1.0"},
+		{"2.5", "-2.5", "0.0"},
+		{"-2.5", "2.5", "0.0"},
+	} {
+		actual, err := mustSigned(c[0]).Add(mustSigned(c[1]))
+		test.NoError(err, c)
+		test.Equal(mustSigned(c[2]), actual, c)
+	}
+
+	actual, err := mustSigned("1.5").Sub(mustSigned("2.5"))
+	test.NoError(err)
+	test.Equal(mustSigned("-1.0"), actual)
+
+	_, err = mustSigned("-99999999999.0").Sub(mustSigned("1.0"))
+	test.Error(err)
+}
+
+func TestSigned_MultiplyAndDivide(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := mustSigned("-1.5").Multiply(mustSigned("2.0"))
+	test.NoError(err)
+	test.Equal(mustSigned("-3.0"), actual)
+
+	actual, err = mustSigned("-1.5").Multiply(mustSigned("-2.0"))
+	test.NoError(err)
+	test.Equal(mustSigned("3.0"), actual)
+
+	actual, err = mustSigned("-1.5").Multiply(mustSigned("0.0"))
+	test.NoError(err)
+	test.Equal(Signed{}, actual)
+
+	actual, err = mustSigned("-0.00000001").MultiplyRound(mustSigned("0.5"), RoundUp)
+	test.NoError(err)
+	test.Equal(mustSigned("-0.00000001"), actual)
+
+	actual, err = mustSigned("-0.00000001").MultiplyRound(mustSigned("0.5"), RoundDown)
+	test.NoError(err)
+	test.Equal(Signed{}, actual)
+
+	actual, err = mustSigned("-1.0").Div(mustSigned("3.0"), RoundHalfEven)
+	test.NoError(err)
+	test.Equal(mustSigned("-0.33333333"), actual)
+
+	_, err = mustSigned("-1.0").Div(Signed{}, RoundHalfEven)
+	test.Error(err)
+
+	actual, err = mustSigned("-1.235").Round(2, RoundHalfUp)
+	test.NoError(err)
+	test.Equal(mustSigned("-1.24"), actual)
+}
@@ -0,0 +1,104 @@
+package stats
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/openware/decimal"
+)
+
+// SMA computes simple moving average of the last observations, e.g. for
+// price indicators. It's not safe for concurrent use.
+type SMA struct {
+	mode   decimal.RoundingMode
+	window []decimal.Decimal
+	next   int
+	full   bool
+}
+
+// NewSMA returns SMA of given number of the last observations, which is
+// rounded once with given mode. Function will return error if period is not
+// positive.
+func NewSMA(period int, mode decimal.RoundingMode) (*SMA, error) {
+	if period <= 0 {
+		return nil, fmt.Errorf("moving average period should be positive: %d", period)
+	}
+
+	return &SMA{mode: mode, window: make([]decimal.Decimal, period)}, nil
+}
+
+// Add adds observation and drops the oldest one if window is full.
+func (average *SMA) Add(value decimal.Decimal) {
+	average.window[average.next] = value
+
+	average.next++
+	if average.next == len(average.window) {
+		average.next = 0
+		average.full = true
+	}
+}
+
+// Value returns mean of observations in window and false until number of
+// observations reaches period. Observations are summed exactly, see
+// decimal.Mean().
+func (average *SMA) Value() (decimal.Decimal, bool) {
+	if !average.full {
+		return 0, false
+	}
+
+	// Mean of non-empty window never fails.
+	mean, _ := decimal.Mean(average.window, average.mode)
+
+	return mean, true
+}
+
+// EMA computes exponential moving average with smoothing factor 2 / (n + 1)
+// for period n. It's not safe for concurrent use.
+type EMA struct {
+	mode   decimal.RoundingMode
+	period *big.Int
+	value  decimal.Decimal
+	ready  bool
+}
+
+// NewEMA returns EMA with given period. First observation is used as
+// initial average. Function will return error if period is not positive.
+func NewEMA(period int, mode decimal.RoundingMode) (*EMA, error) {
+	if period <= 0 {
+		return nil, fmt.Errorf("moving average period should be positive: %d", period)
+	}
+
+	return &EMA{mode: mode, period: big.NewInt(int64(period))}, nil
+}
+
+// Add updates average with given observation. Updated average is computed
+// exactly as (average × (n - 1) + 2 × value) / (n + 1) and rounded once
+// with mode of EMA, so every observation is rounded exactly once.
+func (average *EMA) Add(value decimal.Decimal) {
+	if !average.ready {
+		average.value, average.ready = value, true
+		return
+	}
+
+	var previous, next big.Int
+	previous.Sub(average.period, big.NewInt(1))
+	next.Add(average.period, big.NewInt(1))
+
+	weighted := average.value.Big().
+		Mul(decimal.NewBigDecimal(&previous, 0)).
+		Add(value.Big().Mul(decimal.NewBigDecimal(big.NewInt(2), 0)))
+
+	updated, _ := weighted.Quo(
+		decimal.NewBigDecimal(&next, 0),
+		decimal.MaxPointsFractional,
+		average.mode,
+	)
+
+	// Updated average is between previous average and value, so it fits.
+	average.value, _ = updated.Decimal(decimal.RoundDown)
+}
+
+// Value returns current average and false if there were no observations.
+func (average *EMA) Value() (decimal.Decimal, bool) {
+	return average.value, average.ready
+}
@@ -0,0 +1,113 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openware/decimal"
+)
+
+func TestSMA_AveragesWindow(t *testing.T) {
+	test := assert.New(t)
+
+	average, err := NewSMA(3, decimal.RoundDown)
+	test.NoError(err)
+
+	_, ok := average.Value()
+	test.False(ok)
+
+	for _, value := range parse("1.0", "2.0") {
+		average.Add(value)
+	}
+
+	_, ok = average.Value()
+	test.False(ok)
+
+	average.Add(decimal.Must(decimal.FromString("4.0")))
+
+	value, ok := average.Value()
+	test.True(ok)
+	test.Equal("2.33333333", value.String())
+
+	average.Add(decimal.Must(decimal.FromString("6.0")))
+
+	value, ok = average.Value()
+	test.True(ok)
+	test.Equal("4.00000000", value.String())
+
+	_, err = NewSMA(0, decimal.RoundDown)
+	test.Error(err)
+}
+
+func TestSMA_Rounding(t *testing.T) {
+	test := assert.New(t)
+
+	average, err := NewSMA(2, decimal.RoundUp)
+	test.NoError(err)
+
+	average.Add(1)
+	average.Add(2)
+
+	value, ok := average.Value()
+	test.True(ok)
+	test.Equal(decimal.Decimal(2), value)
+}
+
+func TestEMA_Smooths(t *testing.T) {
+	test := assert.New(t)
+
+	average, err := NewEMA(3, decimal.RoundHalfEven)
+	test.NoError(err)
+
+	_, ok := average.Value()
+	test.False(ok)
+
+	// Smoothing factor of period 3 is 0.5.
+	for _, c := range [][2]string{
+		{"10.0", "10.00000000"},
+		{"20.0", "15.00000000"},
+		{"20.0", "17.50000000"},
+		{"0.0", "8.75000000"},
+	} {
+		average.Add(decimal.Must(decimal.FromString(c[0])))
+
+		value, ok := average.Value()
+		test.True(ok)
+		test.Equal(c[1], value.String(), c[0])
+	}
+
+	_, err = NewEMA(-1, decimal.RoundDown)
+	test.Error(err)
+}
+
+func TestEMA_RoundsEveryStep(t *testing.T) {
+	test := assert.New(t)
+
+	// Smoothing factor of period 2 is 2/3.
+	down, err := NewEMA(2, decimal.RoundDown)
+	test.NoError(err)
+
+	up, err := NewEMA(2, decimal.RoundUp)
+	test.NoError(err)
+
+	for _, average := range []*EMA{down, up} {
+		average.Add(0)
+		average.Add(decimal.Must(decimal.FromString("1.0")))
+	}
+
+	value, _ := down.Value()
+	test.Equal("0.66666666", value.String())
+
+	value, _ = up.Value()
+	test.Equal("0.66666667", value.String())
+
+	average, err := NewEMA(1, decimal.RoundDown)
+	test.NoError(err)
+
+	average.Add(decimal.Must(decimal.FromString("1.0")))
+	average.Add(decimal.Must(decimal.FromString("99999999999.99999999")))
+
+	value, _ = average.Value()
+	test.Equal("99999999999.99999999", value.String())
+}
@@ -0,0 +1,155 @@
+package stats
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/openware/decimal"
+)
+
+// Histogram counts observed values in buckets with given upper bounds, e.g.
+// for trade size or spread distributions. Bucket with upper bound b counts
+// values greater than previous bound and less than or equal to b; values
+// greater than the last bound are counted in overflow bucket. Histogram is
+// safe for concurrent use.
+//
+// Example:
+//	sizes, err := stats.NewHistogram(stats.Bounds("0.01", "0.1", "1.0", "10.0"))
+//	sizes.Observe(trade.Amount)
+//	p99, err := sizes.Quantile(decimal.Lit(99000000), decimal.RoundUp)
+type Histogram struct {
+	bounds []decimal.Decimal
+
+	mutex  sync.Mutex
+	counts []uint64
+	total  uint64
+}
+
+// NewHistogram returns empty Histogram with given upper bounds, which are
+// copied. Function will return error if there are no bounds or they are not
+// strictly ascending.
+func NewHistogram(bounds []decimal.Decimal) (*Histogram, error) {
+	if len(bounds) == 0 {
+		return nil, fmt.Errorf("histogram needs at least one bucket bound")
+	}
+
+	for i := 1; i < len(bounds); i++ {
+		if bounds[i] <= bounds[i-1] {
+			return nil, fmt.Errorf(
+				"histogram bounds should be strictly ascending: %s after %s",
+				bounds[i].String(),
+				bounds[i-1].String(),
+			)
+		}
+	}
+
+	return &Histogram{
+		bounds: append([]decimal.Decimal(nil), bounds...),
+		counts: make([]uint64, len(bounds)+1),
+	}, nil
+}
+
+// Bounds returns Decimal values parsed from given strings. It panics if any
+// of them can't be parsed, so it's meant for constant bounds.
+func Bounds(values ...string) []decimal.Decimal {
+	bounds := make([]decimal.Decimal, len(values))
+	for i, value := range values {
+		bounds[i] = decimal.Must(decimal.FromString(value))
+	}
+
+	return bounds
+}
+
+// Observe counts given value in its bucket.
+func (histogram *Histogram) Observe(value decimal.Decimal) {
+	bucket, _ := decimal.SearchDecimals(histogram.bounds, value)
+
+	histogram.mutex.Lock()
+	defer histogram.mutex.Unlock()
+
+	histogram.counts[bucket]++
+	histogram.total++
+}
+
+// Bounds returns copy of upper bounds of buckets.
+func (histogram *Histogram) Bounds() []decimal.Decimal {
+	return append([]decimal.Decimal(nil), histogram.bounds...)
+}
+
+// Counts returns number of values observed in every bucket, in order of
+// bounds, followed by number of values in overflow bucket.
+func (histogram *Histogram) Counts() []uint64 {
+	histogram.mutex.Lock()
+	defer histogram.mutex.Unlock()
+
+	return append([]uint64(nil), histogram.counts...)
+}
+
+// Count returns number of observed values.
+func (histogram *Histogram) Count() uint64 {
+	histogram.mutex.Lock()
+	defer histogram.mutex.Unlock()
+
+	return histogram.total
+}
+
+// Quantile returns estimate of given quantile, from 0 to 1, of observed
+// values, e.g. 0.99 for 99th percentile. It assumes values are distributed
+// uniformly within bucket and interpolates linearly between its bounds,
+// like Prometheus does; first bucket starts at zero, and quantiles which
+// fall into overflow bucket are estimated as the last bound. Result is
+// computed exactly and rounded once with given mode. Method will return
+// error if no values were observed or quantile is greater than 1.
+func (histogram *Histogram) Quantile(
+	quantile decimal.Decimal,
+	mode decimal.RoundingMode,
+) (decimal.Decimal, error) {
+	if quantile > decimal.Lit(1_00000000) {
+		return 0, fmt.Errorf(
+			"quantile should be from 0 to 1: %s", quantile.String(),
+		)
+	}
+
+	counts := histogram.Counts()
+
+	var total uint64
+	for _, count := range counts {
+		total += count
+	}
+
+	if total == 0 {
+		return 0, fmt.Errorf("quantile of empty histogram is undefined")
+	}
+
+	rank := quantile.Big().Mul(count(total))
+
+	var before uint64
+	for bucket, inBucket := range counts[:len(histogram.bounds)] {
+		if inBucket == 0 || rank.Cmp(count(before+inBucket)) > 0 {
+			before += inBucket
+			continue
+		}
+
+		var lower decimal.Decimal
+		if bucket > 0 {
+			lower = histogram.bounds[bucket-1]
+		}
+
+		upper := histogram.bounds[bucket]
+
+		// lower + (upper - lower) × (rank - before) / inBucket
+		offset, _ := (upper - lower).Big().
+			Mul(rank.Sub(count(before))).
+			Quo(count(inBucket), decimal.MaxPointsFractional, mode)
+
+		return lower.Big().Add(offset).Decimal(mode)
+	}
+
+	return histogram.bounds[len(histogram.bounds)-1], nil
+}
+
+// count returns given number of values as BigDecimal.
+func count(n uint64) decimal.BigDecimal {
+	return decimal.NewBigDecimal(new(big.Int).SetUint64(n), 0)
+}
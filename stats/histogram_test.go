@@ -0,0 +1,132 @@
+package stats
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openware/decimal"
+)
+
+func TestNewHistogram_ValidatesBounds(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := NewHistogram(nil)
+	test.Error(err)
+
+	_, err = NewHistogram(Bounds("1.0", "1.0"))
+	test.Error(err)
+
+	_, err = NewHistogram(Bounds("2.0", "1.0"))
+	test.Error(err)
+
+	bounds := Bounds("1.0", "2.0")
+
+	histogram, err := NewHistogram(bounds)
+	test.NoError(err)
+
+	bounds[0] = 0
+	test.Equal(Bounds("1.0", "2.0"), histogram.Bounds())
+
+	test.Panics(func() { Bounds("abc") })
+}
+
+func TestHistogram_Observe_CountsInBuckets(t *testing.T) {
+	test := assert.New(t)
+
+	histogram, err := NewHistogram(Bounds("1.0", "10.0", "100.0"))
+	test.NoError(err)
+
+	for _, value := range parse("0.0", "0.5", "1.0", "1.00000001", "10.0", "99.0", "100.00000001", "1000.0") {
+		histogram.Observe(value)
+	}
+
+	test.Equal([]uint64{3, 2, 1, 2}, histogram.Counts())
+	test.Equal(uint64(8), histogram.Count())
+}
+
+func TestHistogram_Observe_Concurrent(t *testing.T) {
+	test := assert.New(t)
+
+	histogram, err := NewHistogram(Bounds("1.0"))
+	test.NoError(err)
+
+	var group sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			for j := 0; j < 1000; j++ {
+				histogram.Observe(decimal.Decimal(j % 2 * 2e8))
+			}
+		}()
+	}
+
+	group.Wait()
+
+	test.Equal([]uint64{4000, 4000}, histogram.Counts())
+}
+
+func TestHistogram_Quantile_Interpolates(t *testing.T) {
+	test := assert.New(t)
+
+	histogram, err := NewHistogram(Bounds("10.0", "20.0", "40.0"))
+	test.NoError(err)
+
+	// 2 values in (0, 10], 4 in (10, 20], 2 in (20, 40].
+	for _, value := range parse("5.0", "6.0", "11.0", "12.0", "13.0", "14.0", "30.0", "31.0") {
+		histogram.Observe(value)
+	}
+
+	for quantile, expected := range map[string]string{
+		"0.0":   "0.00000000",
+		"0.125": "5.00000000",
+		"0.25":  "10.00000000",
+		"0.5":   "15.00000000",
+		"0.75":  "20.00000000",
+		"0.9":   "32.00000000",
+		"1.0":   "40.00000000",
+	} {
+		actual, err := histogram.Quantile(decimal.Must(decimal.FromString(quantile)), decimal.RoundDown)
+		test.NoError(err, quantile)
+		test.Equal(expected, actual.String(), quantile)
+	}
+
+	// 0.3 × 8 = 2.4 is 0.4 of 4 values into (10, 20].
+	actual, err := histogram.Quantile(decimal.Must(decimal.FromString("0.3")), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("11.00000000", actual.String())
+
+	// 0.33333333 × 8 is 2.66666664 rank.
+	actual, err = histogram.Quantile(decimal.Must(decimal.FromString("0.33333333")), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("11.66666660", actual.String())
+
+	_, err = histogram.Quantile(decimal.Must(decimal.FromString("1.00000001")), decimal.RoundDown)
+	test.Error(err)
+}
+
+func TestHistogram_Quantile_Overflow(t *testing.T) {
+	test := assert.New(t)
+
+	histogram, err := NewHistogram(Bounds("1.0", "2.0"))
+	test.NoError(err)
+
+	_, err = histogram.Quantile(decimal.Lit(50000000), decimal.RoundDown)
+	test.Error(err)
+
+	histogram.Observe(decimal.Must(decimal.FromString("0.5")))
+	histogram.Observe(decimal.Must(decimal.FromString("5.0")))
+	histogram.Observe(decimal.Must(decimal.FromString("7.0")))
+
+	actual, err := histogram.Quantile(decimal.Lit(90000000), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("2.00000000", actual.String())
+
+	actual, err = histogram.Quantile(decimal.Lit(30000000), decimal.RoundUp)
+	test.NoError(err)
+	test.Equal("0.90000000", actual.String())
+}
@@ -0,0 +1,93 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/openware/decimal"
+)
+
+// Returns returns period-over-period percent changes of given series, e.g.
+// daily returns of portfolio value: element i is (series[i+1] - series[i]) /
+// series[i] × 100 rounded with given mode, which is applied to magnitude.
+// Series of n values has n - 1 returns. Mode is required since percent
+// change is exact only for some ratios, e.g. 3 to 1 is -66.666...%; like
+// other functions of this package, Returns never consults
+// decimal.DefaultPolicy(). Function will return error if any value other
+// than the last one is zero or return can't be stored in Signed type.
+//
+// Example:
+//	returns, err := stats.Returns(closes, decimal.RoundHalfEven)
+func Returns(series []decimal.Decimal, mode decimal.RoundingMode) ([]decimal.Signed, error) {
+	if len(series) < 2 {
+		return nil, nil
+	}
+
+	returns := make([]decimal.Signed, len(series)-1)
+	for i := range returns {
+		previous, current := series[i], series[i+1]
+		if previous == 0 {
+			return nil, fmt.Errorf("return from zero value is undefined: period %d", i+1)
+		}
+
+		change, _ := current.Big().Sub(previous.Big()).Mul(hundred).
+			Quo(previous.Big(), decimal.MaxPointsFractional, mode)
+
+		magnitude := change
+		if change.Sign() < 0 {
+			magnitude = change.Neg()
+		}
+
+		// Change is rounded to 8 places already, so conversion is exact.
+		units, err := magnitude.Decimal(mode)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"decimal type can't hold return of period %d: %s to %s",
+				i+1,
+				previous.String(),
+				current.String(),
+			)
+		}
+
+		returns[i] = decimal.NewSigned(units, change.Sign() < 0)
+	}
+
+	return returns, nil
+}
+
+// MaxDrawdown returns the greatest decline of given series from its running
+// peak, as percent of peak rounded with given mode, e.g. 25 if value fell
+// from 200 to 150 before recovering. Declines are compared exactly, so only
+// result is rounded; mode is required for the same reason as in Returns().
+// Series which never declines has zero drawdown.
+// Function will return error if there are no values.
+func MaxDrawdown(series []decimal.Decimal, mode decimal.RoundingMode) (decimal.Decimal, error) {
+	if len(series) == 0 {
+		return 0, fmt.Errorf("maximum drawdown of no values is undefined")
+	}
+
+	var peak, worstPeak, worstDecline decimal.Decimal
+	for _, value := range series {
+		if value > peak {
+			peak = value
+			continue
+		}
+
+		// decline / peak > worstDecline / worstPeak
+		decline := peak - value
+		if decline != 0 && (worstDecline == 0 ||
+			decline.Big().Mul(worstPeak.Big()).
+				Cmp(worstDecline.Big().Mul(peak.Big())) > 0) {
+			worstPeak, worstDecline = peak, decline
+		}
+	}
+
+	if worstDecline == 0 {
+		return 0, nil
+	}
+
+	// Drawdown is at most 100, so it always fits.
+	drawdown, _ := worstDecline.Big().Mul(hundred).
+		Quo(worstPeak.Big(), decimal.MaxPointsFractional, mode)
+
+	return drawdown.Decimal(mode)
+}
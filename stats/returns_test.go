@@ -0,0 +1,68 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openware/decimal"
+)
+
+func TestReturns_ComputesPercentChanges(t *testing.T) {
+	test := assert.New(t)
+
+	returns, err := Returns(parse("100.0", "110.0", "99.0", "121.0"), decimal.RoundHalfEven)
+	test.NoError(err)
+	test.Len(returns, 3)
+	test.Equal("10.00000000", returns[0].String())
+	test.Equal("-10.00000000", returns[1].String())
+	test.Equal("22.22222222", returns[2].String())
+
+	returns, err = Returns(parse("3.0", "1.0"), decimal.RoundUp)
+	test.NoError(err)
+	test.Equal("-66.66666667", returns[0].String())
+
+	returns, err = Returns(parse("1.0", "1.0", "0.0"), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("0.00000000", returns[0].String())
+	test.False(returns[0].Negative())
+	test.Equal("-100.00000000", returns[1].String())
+
+	returns, err = Returns(parse("1.0"), decimal.RoundDown)
+	test.NoError(err)
+	test.Empty(returns)
+}
+
+func TestReturns_ReturnsError(t *testing.T) {
+	test := assert.New(t)
+
+	_, err := Returns(parse("1.0", "0.0", "1.0"), decimal.RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "period 2")
+
+	_, err = Returns(parse("0.00000001", "99999999999.0"), decimal.RoundDown)
+	test.Error(err)
+	test.Contains(err.Error(), "can't hold return of period 1")
+}
+
+func TestMaxDrawdown_FindsGreatestDecline(t *testing.T) {
+	test := assert.New(t)
+
+	drawdown, err := MaxDrawdown(
+		parse("100.0", "110.0", "99.0", "121.0", "90.75", "200.0", "160.0"),
+		decimal.RoundHalfEven,
+	)
+	test.NoError(err)
+	test.Equal("25.00000000", drawdown.String())
+
+	drawdown, err = MaxDrawdown(parse("3.0", "2.0"), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("33.33333333", drawdown.String())
+
+	drawdown, err = MaxDrawdown(parse("0.0", "1.0", "2.0"), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal(decimal.Decimal(0), drawdown)
+
+	_, err = MaxDrawdown(nil, decimal.RoundDown)
+	test.Error(err)
+}
@@ -0,0 +1,185 @@
+// Package stats computes descriptive statistics of decimal values, e.g. for
+// risk reports, without converting them to float64. Intermediate values are
+// computed exactly and every result is rounded once to 8 places with given
+// mode.
+package stats
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/openware/decimal"
+)
+
+// Min returns the least of given values. Function will return error if
+// there are no values.
+func Min(values []decimal.Decimal) (decimal.Decimal, error) {
+	min, ok := decimal.Decimals(values).Min()
+	if !ok {
+		return 0, fmt.Errorf("minimum of no values is undefined")
+	}
+
+	return min, nil
+}
+
+// Max returns the greatest of given values. Function will return error if
+// there are no values.
+func Max(values []decimal.Decimal) (decimal.Decimal, error) {
+	max, ok := decimal.Decimals(values).Max()
+	if !ok {
+		return 0, fmt.Errorf("maximum of no values is undefined")
+	}
+
+	return max, nil
+}
+
+// Range returns difference between the greatest and the least of given
+// values. Function will return error if there are no values.
+func Range(values []decimal.Decimal) (decimal.Decimal, error) {
+	min, err := Min(values)
+	if err != nil {
+		return 0, fmt.Errorf("range of no values is undefined")
+	}
+
+	max, _ := Max(values)
+
+	return max - min, nil
+}
+
+// Median returns middle of given values, or mean of two middle values for
+// even number of values, rounded with given mode. Function will return
+// error if there are no values.
+func Median(values []decimal.Decimal, mode decimal.RoundingMode) (decimal.Decimal, error) {
+	return Percentile(values, decimal.Lit(50_00000000), mode)
+}
+
+// hundred is 100 as BigDecimal.
+var hundred = decimal.NewBigDecimal(big.NewInt(100), 0)
+
+// Percentile returns given percentile of values, from 0 to 100, rounded with
+// given mode. It interpolates linearly between closest ranks, i.e. it's
+// sorted[k] + (sorted[k+1] - sorted[k]) × f where k + f = p / 100 × (n - 1),
+// like default method of NumPy. Function will return error if there are no
+// values or percentile is greater than 100.
+func Percentile(
+	values []decimal.Decimal,
+	percentile decimal.Decimal,
+	mode decimal.RoundingMode,
+) (decimal.Decimal, error) {
+	if len(values) == 0 {
+		return 0, fmt.Errorf("percentile of no values is undefined")
+	}
+
+	if percentile > decimal.Lit(100_00000000) {
+		return 0, fmt.Errorf(
+			"percentile should be from 0 to 100: %s", percentile.String(),
+		)
+	}
+
+	sorted := append(decimal.Decimals(nil), values...)
+	sorted.Sort()
+
+	// Percentile has 8 places, so rank has at most 10.
+	last := decimal.NewBigDecimal(big.NewInt(int64(len(sorted)-1)), 0)
+	rank, _ := percentile.Big().Mul(last).Quo(hundred, 10, decimal.RoundDown)
+
+	var index, fraction big.Int
+	index.QuoRem(rank.Coefficient(), big.NewInt(1e10), &fraction)
+
+	lower := sorted[index.Int64()]
+	if fraction.Sign() == 0 {
+		return lower, nil
+	}
+
+	upper := sorted[index.Int64()+1]
+
+	// Result is between lower and upper, so it always fits.
+	return lower.Big().
+		Add((upper - lower).Big().Mul(decimal.NewBigDecimal(&fraction, -10))).
+		Decimal(mode)
+}
+
+// deviation returns n × Σu² - (Σu)² for units u of given values, which is
+// n² × 10^16 times population variance.
+func deviation(values []decimal.Decimal) *big.Int {
+	var sum, squares big.Int
+	for _, value := range values {
+		units := new(big.Int).SetUint64(value.Uint64())
+
+		sum.Add(&sum, units)
+		squares.Add(&squares, units.Mul(units, units))
+	}
+
+	squares.Mul(&squares, big.NewInt(int64(len(values))))
+
+	return squares.Sub(&squares, sum.Mul(&sum, &sum))
+}
+
+// Variance returns population variance of given values, i.e. mean of
+// squared deviations from mean, computed exactly and rounded with given
+// mode. Function will return error if there are no values or variance can't
+// be stored in Decimal type.
+func Variance(values []decimal.Decimal, mode decimal.RoundingMode) (decimal.Decimal, error) {
+	if len(values) == 0 {
+		return 0, fmt.Errorf("variance of no values is undefined")
+	}
+
+	count := big.NewInt(int64(len(values)))
+
+	variance, _ := decimal.NewBigDecimal(deviation(values), -16).Quo(
+		decimal.NewBigDecimal(count.Mul(count, count), 0),
+		decimal.MaxPointsFractional,
+		mode,
+	)
+
+	result, err := variance.Decimal(mode)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"decimal type can't hold variance of %d values", len(values),
+		)
+	}
+
+	return result, nil
+}
+
+// StdDev returns population standard deviation of given values, i.e. square
+// root of Variance(), computed exactly and rounded once with given mode.
+// Function will return error if there are no values.
+func StdDev(values []decimal.Decimal, mode decimal.RoundingMode) (decimal.Decimal, error) {
+	if len(values) == 0 {
+		return 0, fmt.Errorf("standard deviation of no values is undefined")
+	}
+
+	// Standard deviation in units is √D / n for D returned by deviation().
+	var root, scaled, remainder big.Int
+
+	square := deviation(values)
+	count := big.NewInt(int64(len(values)))
+
+	root.Sqrt(square)
+	root.QuoRem(&root, count, &remainder)
+
+	// Exact value is root + f units for 0 ≤ f < 1. Rounding depends only on
+	// whether f is zero and how it compares to half, so it's applied to
+	// root + 0, 0.25, 0.5 or 0.75 units picked accordingly.
+	scaled.Mul(&root, count)
+	scaled.Mul(&scaled, &scaled)
+
+	var quarters int64
+	if scaled.Cmp(square) != 0 {
+		// Compare 4D against ((2 × root + 1) × n)².
+		half := new(big.Int).Lsh(&root, 1)
+		half.Add(half, big.NewInt(1))
+		half.Mul(half, count)
+		half.Mul(half, half)
+
+		quarters = 2 + int64(new(big.Int).Lsh(square, 2).Cmp(half))
+	}
+
+	hundredths := root.Mul(&root, big.NewInt(100))
+	hundredths.Add(hundredths, big.NewInt(25*quarters))
+
+	// Standard deviation never exceeds the greatest value, so it fits.
+	return decimal.NewBigDecimal(hundredths, -decimal.MaxPointsFractional-2).
+		Decimal(mode)
+}
@@ -0,0 +1,167 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openware/decimal"
+)
+
+func parse(values ...string) []decimal.Decimal {
+	result := make([]decimal.Decimal, len(values))
+	for i, value := range values {
+		result[i] = decimal.Must(decimal.FromString(value))
+	}
+
+	return result
+}
+
+func TestMinMaxRange(t *testing.T) {
+	test := assert.New(t)
+
+	values := parse("3.0", "1.5", "4.25")
+
+	min, err := Min(values)
+	test.NoError(err)
+	test.Equal("1.50000000", min.String())
+
+	max, err := Max(values)
+	test.NoError(err)
+	test.Equal("4.25000000", max.String())
+
+	span, err := Range(values)
+	test.NoError(err)
+	test.Equal("2.75000000", span.String())
+
+	_, err = Min(nil)
+	test.Error(err)
+
+	_, err = Max(nil)
+	test.Error(err)
+
+	_, err = Range(nil)
+	test.Error(err)
+}
+
+func TestMedian(t *testing.T) {
+	test := assert.New(t)
+
+	values := parse("3.0", "1.0", "2.0")
+
+	median, err := Median(values, decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("2.00000000", median.String())
+	test.Equal(parse("3.0", "1.0", "2.0"), values)
+
+	median, err = Median(parse("4.0", "1.0", "2.0", "3.0"), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("2.50000000", median.String())
+
+	median, err = Median([]decimal.Decimal{1, 2}, decimal.RoundHalfEven)
+	test.NoError(err)
+	test.Equal(decimal.Decimal(2), median)
+
+	median, err = Median([]decimal.Decimal{1, 2}, decimal.RoundDown)
+	test.NoError(err)
+	test.Equal(decimal.Decimal(1), median)
+
+	_, err = Median(nil, decimal.RoundDown)
+	test.Error(err)
+}
+
+func TestPercentile(t *testing.T) {
+	test := assert.New(t)
+
+	values := parse("15.0", "20.0", "35.0", "40.0", "50.0")
+
+	for percentile, expected := range map[string]string{
+		"0.0":   "15.00000000",
+		"25.0":  "20.00000000",
+		"40.0":  "29.00000000",
+		"90.0":  "46.00000000",
+		"99.9":  "49.96000000",
+		"100.0": "50.00000000",
+	} {
+		actual, err := Percentile(values, decimal.Must(decimal.FromString(percentile)), decimal.RoundDown)
+		test.NoError(err, percentile)
+		test.Equal(expected, actual.String(), percentile)
+	}
+
+	actual, err := Percentile(parse("7.0"), decimal.Must(decimal.FromString("33.0")), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("7.00000000", actual.String())
+
+	_, err = Percentile(values, decimal.Must(decimal.FromString("100.00000001")), decimal.RoundDown)
+	test.Error(err)
+
+	_, err = Percentile(nil, 0, decimal.RoundDown)
+	test.Error(err)
+}
+
+func TestVariance(t *testing.T) {
+	test := assert.New(t)
+
+	values := parse("2.0", "4.0", "4.0", "4.0", "5.0", "5.0", "7.0", "9.0")
+
+	variance, err := Variance(values, decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("4.00000000", variance.String())
+
+	variance, err = Variance(parse("1.0", "2.0", "4.0"), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("1.55555555", variance.String())
+
+	variance, err = Variance(parse("1.0", "2.0", "4.0"), decimal.RoundUp)
+	test.NoError(err)
+	test.Equal("1.55555556", variance.String())
+
+	variance, err = Variance(parse("5.0"), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal(decimal.Decimal(0), variance)
+
+	_, err = Variance(parse("0.0", "99999999999.0"), decimal.RoundDown)
+	test.Error(err)
+
+	_, err = Variance(nil, decimal.RoundDown)
+	test.Error(err)
+}
+
+func TestStdDev(t *testing.T) {
+	test := assert.New(t)
+
+	values := parse("2.0", "4.0", "4.0", "4.0", "5.0", "5.0", "7.0", "9.0")
+
+	deviation, err := StdDev(values, decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("2.00000000", deviation.String())
+
+	// √(14/9) = 1.247219128924647...
+	deviation, err = StdDev(parse("1.0", "2.0", "4.0"), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("1.24721912", deviation.String())
+
+	deviation, err = StdDev(parse("1.0", "2.0", "4.0"), decimal.RoundHalfUp)
+	test.NoError(err)
+	test.Equal("1.24721913", deviation.String())
+
+	// Deviation of 0 and 0.00000001 is exactly 0.000000005.
+	deviation, err = StdDev([]decimal.Decimal{0, 1}, decimal.RoundHalfEven)
+	test.NoError(err)
+	test.Equal(decimal.Decimal(0), deviation)
+
+	deviation, err = StdDev([]decimal.Decimal{0, 1}, decimal.RoundHalfUp)
+	test.NoError(err)
+	test.Equal(decimal.Decimal(1), deviation)
+
+	deviation, err = StdDev([]decimal.Decimal{0, 3}, decimal.RoundHalfEven)
+	test.NoError(err)
+	test.Equal(decimal.Decimal(2), deviation)
+
+	deviation, err = StdDev(parse("0.0", "99999999999.0"), decimal.RoundDown)
+	test.NoError(err)
+	test.Equal("49999999999.50000000", deviation.String())
+
+	_, err = StdDev(nil, decimal.RoundDown)
+	test.Error(err)
+}
@@ -0,0 +1,153 @@
+package decimal
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// Sum returns sum of given values. Function will return error if sum can't
+// be stored in Decimal type.
+//
+// Example:
+//	decimal.Sum(balance, deposit, bonus)
+func Sum(values ...Decimal) (Decimal, error) {
+	return SumSlice(values)
+}
+
+// SumSlice returns sum of given values like Sum() does. Values are summed
+// into 128-bit accumulator, so overflow is checked once for whole slice.
+func SumSlice(values []Decimal) (Decimal, error) {
+	sum, ok := fromUint128(sum128(values))
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold sum of %d values", len(values),
+		)
+	}
+
+	return sum, nil
+}
+
+// SumParallel returns sum of given values computed by given number of
+// goroutines, each summing contiguous chunk of values into 128-bit
+// accumulator, so billions of values can be summed without intermediate
+// overflow checks. Non-positive number of workers means GOMAXPROCS. Result
+// doesn't depend on number of workers. Function will return error if sum
+// can't be stored in Decimal type.
+func SumParallel(values []Decimal, workers int) (Decimal, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > len(values) {
+		workers = len(values)
+	}
+
+	if workers == 0 {
+		return 0, nil
+	}
+
+	type accumulator struct {
+		hi, lo uint64
+	}
+
+	chunk := (len(values) + workers - 1) / workers
+	workers = (len(values) + chunk - 1) / chunk
+	accumulators := make([]accumulator, workers)
+
+	var group sync.WaitGroup
+	for i := range accumulators {
+		start, end := i*chunk, (i+1)*chunk
+		if end > len(values) {
+			end = len(values)
+		}
+
+		group.Add(1)
+		go func(sum *accumulator, values []Decimal) {
+			defer group.Done()
+
+			sum.hi, sum.lo = sum128(values)
+		}(&accumulators[i], values[start:end])
+	}
+	group.Wait()
+
+	var hi, lo uint64
+	for _, sum := range accumulators {
+		hi, lo, _ = add128(hi, lo, sum.hi, sum.lo)
+	}
+
+	sum, ok := fromUint128(hi, lo)
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold sum of %d values", len(values),
+		)
+	}
+
+	return sum, nil
+}
+
+// Accumulator sums values into 128-bit accumulator, so millions of values
+// can be added without intermediate overflow. Total is checked once, when
+// it's converted back to Decimal. Zero value is empty accumulator.
+// Accumulator is not safe for concurrent use.
+//
+// Example:
+//	var total decimal.Accumulator
+//	for _, trade := range trades {
+//		total.Add(trade.Amount)
+//	}
+//	sum, err := total.Total()
+type Accumulator struct {
+	hi, lo uint64
+	count  uint64
+}
+
+// Add adds given value to accumulator. Method will return error and leave
+// accumulator unchanged if 128-bit sum overflows, which needs more than
+// 2^64 values.
+func (accumulator *Accumulator) Add(value Decimal) error {
+	hi, lo, ok := add128(accumulator.hi, accumulator.lo, 0, value.Uint64())
+	if !ok {
+		return fmt.Errorf(
+			"decimal accumulator can't hold sum of %d values",
+			accumulator.count+1,
+		)
+	}
+
+	accumulator.hi, accumulator.lo = hi, lo
+	accumulator.count++
+
+	return nil
+}
+
+// Count returns number of values added since creation or last Reset().
+func (accumulator *Accumulator) Count() uint64 {
+	return accumulator.count
+}
+
+// Total returns sum of added values. Method will return error if sum can't
+// be stored in Decimal type.
+func (accumulator *Accumulator) Total() (Decimal, error) {
+	total, ok := fromUint128(accumulator.hi, accumulator.lo)
+	if !ok {
+		return 0, fmt.Errorf(
+			"decimal type can't hold sum of %d values", accumulator.count,
+		)
+	}
+
+	return total, nil
+}
+
+// Big returns exact sum of added values, which may exceed range of Decimal
+// type.
+func (accumulator *Accumulator) Big() BigDecimal {
+	return BigDecimal{
+		coefficient: bigWords(accumulator.hi, accumulator.lo),
+		exponent:    -MaxPointsFractional,
+	}
+}
+
+// Reset empties accumulator.
+func (accumulator *Accumulator) Reset() {
+	*accumulator = Accumulator{}
+}
@@ -0,0 +1,136 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum_SumsValues(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := Sum(Must(FromString("1.5")), Must(FromString("2.25")), 1)
+	test.NoError(err)
+	test.Equal("3.75000001", actual.String())
+
+	actual, err = Sum()
+	test.NoError(err)
+	test.Equal(Decimal(0), actual)
+
+	actual, err = SumSlice([]Decimal{Decimal(Max - 2), 1})
+	test.NoError(err)
+	test.Equal(Decimal(Max-1), actual)
+}
+
+func TestSum_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("99999999999.99999999"))
+
+	_, err := Sum(max, 1)
+	test.Error(err)
+	test.Contains(err.Error(), "sum of 2 values")
+
+	values := make([]Decimal, 3)
+	for i := range values {
+		values[i] = max
+	}
+
+	_, err = SumSlice(values)
+	test.Error(err)
+}
+
+func TestSumParallel_DoesNotDependOnWorkers(t *testing.T) {
+	test := assert.New(t)
+
+	values := make([]Decimal, 1001)
+	for i := range values {
+		values[i] = Decimal(i)
+	}
+
+	for _, workers := range []int{-1, 0, 1, 2, 3, 7, 1001, 5000} {
+		actual, err := SumParallel(values, workers)
+		test.NoError(err, workers)
+		test.Equal(Decimal(1000*1001/2), actual, workers)
+	}
+
+	actual, err := SumParallel(values[:8], 7)
+	test.NoError(err)
+	test.Equal(Decimal(28), actual)
+
+	actual, err = SumParallel(nil, 4)
+	test.NoError(err)
+	test.Equal(Decimal(0), actual)
+}
+
+func TestSumParallel_ToleratesIntermediateOverflowOfUint64(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("99999999999.99999999"))
+
+	_, err := SumParallel([]Decimal{max, max, max}, 1)
+	test.Error(err)
+	test.Contains(err.Error(), "sum of 3 values")
+
+	_, err = SumParallel([]Decimal{max, 1}, 2)
+	test.Error(err)
+
+	actual, err := SumParallel([]Decimal{max - 1, 1}, 2)
+	test.NoError(err)
+	test.Equal(max, actual)
+}
+
+func BenchmarkSumParallel(b *testing.B) {
+	values := make([]Decimal, 1<<20)
+	for i := range values {
+		values[i] = Decimal(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SumParallel(values, 0)
+	}
+}
+
+func TestAccumulator_SumsWithoutIntermediateOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	var accumulator Accumulator
+
+	total, err := accumulator.Total()
+	test.NoError(err)
+	test.Equal(Decimal(0), total)
+
+	for i := 0; i < 3; i++ {
+		test.NoError(accumulator.Add(Decimal(Max - 1)))
+	}
+
+	test.Equal(uint64(3), accumulator.Count())
+	test.Equal("299999999999.99999997", accumulator.Big().String())
+
+	_, err = accumulator.Total()
+	test.Error(err)
+	test.Contains(err.Error(), "sum of 3 values")
+
+	accumulator.Reset()
+	test.Equal(uint64(0), accumulator.Count())
+
+	test.NoError(accumulator.Add(Must(FromString("1.5"))))
+	test.NoError(accumulator.Add(Must(FromString("2.25"))))
+
+	total, err = accumulator.Total()
+	test.NoError(err)
+	test.Equal(Must(FromString("3.75")), total)
+}
+
+func TestAccumulator_Add_RejectsOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	accumulator := Accumulator{hi: ^uint64(0), lo: ^uint64(0), count: 7}
+
+	test.Error(accumulator.Add(1))
+	test.Equal(Accumulator{hi: ^uint64(0), lo: ^uint64(0), count: 7}, accumulator)
+
+	test.NoError(accumulator.Add(0))
+	test.Equal(uint64(8), accumulator.Count())
+}
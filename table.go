@@ -0,0 +1,208 @@
+package decimal
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// Table holds balances of assets (rows) across accounts (columns) and
+// computes exact row, column and grand totals.
+//
+// Rows and columns are kept in order of first appearance.
+//
+// Example:
+//	table := decimal.NewTable()
+//	table.Set("BTC", "hot", decimal.Must(decimal.FromString("1.5")))
+//	table.Set("BTC", "cold", decimal.Must(decimal.FromString("10.0")))
+//	table.RowTotal("BTC") // will return 11.50000000
+type Table struct {
+	assets   []string
+	accounts []string
+	cells    map[string]map[string]Decimal
+}
+
+// NewTable returns empty Table.
+func NewTable() *Table {
+	return &Table{
+		cells: map[string]map[string]Decimal{},
+	}
+}
+
+// Assets returns row names in order of first appearance.
+func (table *Table) Assets() []string {
+	return append([]string(nil), table.assets...)
+}
+
+// Accounts returns column names in order of first appearance.
+func (table *Table) Accounts() []string {
+	return append([]string(nil), table.accounts...)
+}
+
+// Set stores value of given asset on given account, replacing previous one.
+func (table *Table) Set(asset, account string, value Decimal) {
+	table.row(asset)[table.column(account)] = value
+}
+
+// Add adds value to balance of given asset on given account and returns
+// error if resulting balance can't be stored in Decimal type. Table is not
+// changed on error.
+func (table *Table) Add(asset, account string, value Decimal) error {
+	balance := table.cells[asset][account]
+
+	sum, ok := add(balance, value)
+	if !ok {
+		return fmt.Errorf(
+			"decimal type can't hold balance of %s on %s: %s + %s",
+			asset,
+			account,
+			balance.String(),
+			value.String(),
+		)
+	}
+
+	table.row(asset)[table.column(account)] = sum
+
+	return nil
+}
+
+// Get returns balance of given asset on given account. Missing cells are
+// reported as zero.
+func (table *Table) Get(asset, account string) Decimal {
+	return table.cells[asset][account]
+}
+
+// RowTotal returns sum of balances of given asset across all accounts.
+func (table *Table) RowTotal(asset string) (Decimal, error) {
+	var total Decimal
+
+	for _, account := range table.accounts {
+		var ok bool
+
+		total, ok = add(total, table.cells[asset][account])
+		if !ok {
+			return 0, fmt.Errorf(
+				"decimal type can't hold total of asset %s", asset,
+			)
+		}
+	}
+
+	return total, nil
+}
+
+// ColumnTotal returns sum of balances of all assets on given account.
+func (table *Table) ColumnTotal(account string) (Decimal, error) {
+	var total Decimal
+
+	for _, asset := range table.assets {
+		var ok bool
+
+		total, ok = add(total, table.cells[asset][account])
+		if !ok {
+			return 0, fmt.Errorf(
+				"decimal type can't hold total of account %s", account,
+			)
+		}
+	}
+
+	return total, nil
+}
+
+// GrandTotal returns sum of all balances in table.
+func (table *Table) GrandTotal() (Decimal, error) {
+	var total Decimal
+
+	for _, asset := range table.assets {
+		row, err := table.RowTotal(asset)
+		if err != nil {
+			return 0, err
+		}
+
+		var ok bool
+
+		total, ok = add(total, row)
+		if !ok {
+			return 0, fmt.Errorf("decimal type can't hold grand total")
+		}
+	}
+
+	return total, nil
+}
+
+// WriteCSV writes table to given writer as CSV with header row of account
+// names, one row per asset and trailing "total" column and row.
+//
+// Example:
+//	asset,hot,cold,total
+//	BTC,1.50000000,10.00000000,11.50000000
+//	total,1.50000000,10.00000000,11.50000000
+func (table *Table) WriteCSV(writer io.Writer) error {
+	out := csv.NewWriter(writer)
+
+	header := append([]string{"asset"}, table.accounts...)
+	if err := out.Write(append(header, "total")); err != nil {
+		return err
+	}
+
+	for _, asset := range table.assets {
+		record := []string{asset}
+		for _, account := range table.accounts {
+			record = append(record, table.cells[asset][account].String())
+		}
+
+		total, err := table.RowTotal(asset)
+		if err != nil {
+			return err
+		}
+
+		if err := out.Write(append(record, total.String())); err != nil {
+			return err
+		}
+	}
+
+	record := []string{"total"}
+	for _, account := range table.accounts {
+		total, err := table.ColumnTotal(account)
+		if err != nil {
+			return err
+		}
+
+		record = append(record, total.String())
+	}
+
+	total, err := table.GrandTotal()
+	if err != nil {
+		return err
+	}
+
+	if err := out.Write(append(record, total.String())); err != nil {
+		return err
+	}
+
+	out.Flush()
+
+	return out.Error()
+}
+
+func (table *Table) row(asset string) map[string]Decimal {
+	row, ok := table.cells[asset]
+	if !ok {
+		row = map[string]Decimal{}
+		table.cells[asset] = row
+		table.assets = append(table.assets, asset)
+	}
+
+	return row
+}
+
+func (table *Table) column(account string) string {
+	for _, known := range table.accounts {
+		if known == account {
+			return account
+		}
+	}
+
+	table.accounts = append(table.accounts, account)
+
+	return account
+}
@@ -0,0 +1,73 @@
+package decimal
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_Totals_SumsRowsAndColumns(t *testing.T) {
+	test := assert.New(t)
+
+	table := NewTable()
+	table.Set("BTC", "hot", Must(FromString("1.5")))
+	table.Set("BTC", "cold", Must(FromString("10.0")))
+	table.Set("ETH", "hot", Must(FromString("0.00000001")))
+
+	row, err := table.RowTotal("BTC")
+	test.NoError(err)
+	test.Equal("11.50000000", row.String())
+
+	column, err := table.ColumnTotal("hot")
+	test.NoError(err)
+	test.Equal("1.50000001", column.String())
+
+	total, err := table.GrandTotal()
+	test.NoError(err)
+	test.Equal("11.50000001", total.String())
+}
+
+func TestTable_Add_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	table := NewTable()
+	test.NoError(table.Add("BTC", "hot", Must(FromString("99999999999.0"))))
+
+	err := table.Add("BTC", "hot", Must(FromString("1.0")))
+	test.Error(err)
+	test.Contains(err.Error(), "can't hold balance")
+	test.Equal("99999999999.00000000", table.Get("BTC", "hot").String())
+	test.Equal([]string{"BTC"}, table.Assets())
+	test.Equal([]string{"hot"}, table.Accounts())
+}
+
+func TestTable_GrandTotal_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	table := NewTable()
+	table.Set("BTC", "hot", Must(FromString("99999999999.0")))
+	table.Set("ETH", "hot", Must(FromString("1.0")))
+
+	_, err := table.GrandTotal()
+	test.Error(err)
+}
+
+func TestTable_WriteCSV_WritesTotals(t *testing.T) {
+	test := assert.New(t)
+
+	table := NewTable()
+	table.Set("BTC", "hot", Must(FromString("1.5")))
+	table.Set("BTC", "cold", Must(FromString("10.0")))
+	table.Set("ETH", "cold", Must(FromString("2.0")))
+
+	var buffer bytes.Buffer
+	test.NoError(table.WriteCSV(&buffer))
+	test.Equal(
+		"asset,hot,cold,total\n"+
+			"BTC,1.50000000,10.00000000,11.50000000\n"+
+			"ETH,0.00000000,2.00000000,2.00000000\n"+
+			"total,1.50000000,12.00000000,13.50000000\n",
+		buffer.String(),
+	)
+}
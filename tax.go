@@ -0,0 +1,92 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Tax is breakdown of amount into net amount and tax, e.g. VAT. Net + Tax
+// always equals Gross exactly.
+type Tax struct {
+	Net   Decimal
+	Tax   Decimal
+	Gross Decimal
+}
+
+// AddTax returns breakdown of tax-exclusive amount: tax is net × rate
+// rounded once to given number of places with given mode, e.g. 2 for EUR,
+// and gross is net plus tax. Rate is fraction, e.g. 0.2 for 20%. Function
+// will return error if number of places is not in range from 0 to 8 or
+// gross can't be stored in Decimal type.
+//
+// Example:
+//	decimal.AddTax(net, rate, 2, decimal.RoundHalfUp) // 10.00 + 1.90 = 11.90 for 10 at 19%
+func AddTax(net, rate Decimal, places int, mode RoundingMode) (Tax, error) {
+	if err := checkPlaces(places); err != nil {
+		return Tax{}, err
+	}
+
+	tax, err := WithMaxScale(places).Rounding(mode).Multiply(net, rate)
+	if err != nil {
+		return Tax{}, err
+	}
+
+	gross, err := net.Add(tax)
+	if err != nil {
+		return Tax{}, err
+	}
+
+	return Tax{Net: net, Tax: tax, Gross: gross}, nil
+}
+
+// ExtractTax returns breakdown of tax-inclusive amount: net is gross / (1 +
+// rate) rounded once to given number of places with given mode and tax is
+// the rest of gross, so they always reconcile to gross even though both
+// can't be rounded independently. Rate is fraction, e.g. 0.2 for 20%.
+// Function will return error if number of places is not in range from 0 to
+// 8 or rate is too large.
+//
+// Example:
+//	decimal.ExtractTax(gross, rate, 2, decimal.RoundHalfUp) // 10.00 + 1.90 = 11.90 for 11.90 at 19%
+func ExtractTax(gross, rate Decimal, places int, mode RoundingMode) (Tax, error) {
+	if err := checkPlaces(places); err != nil {
+		return Tax{}, err
+	}
+
+	divisor, ok := add(Decimal(MaxFractional), rate)
+	if !ok {
+		return Tax{}, fmt.Errorf("decimal type can't hold tax rate %s", rate.String())
+	}
+
+	// net = gross × 1e8 / (1e8 + rate) in units of 0.00000001, rounded to
+	// multiple of unit.
+	unit := powers[MaxPointsFractional-places]
+
+	var numerator, denominator big.Int
+	numerator.Mul(bigDecimal(gross), bigFractional)
+	denominator.Mul(bigDecimal(divisor), new(big.Int).SetUint64(unit))
+
+	units := mode.apply("tax.extract", &numerator, &denominator, unit)
+	units.Mul(units, new(big.Int).SetUint64(unit))
+
+	// Net never exceeds gross, except when rounded up to unit above it.
+	net, ok := fromBig(units)
+	if !ok || net > gross {
+		net = gross
+	}
+
+	return Tax{Net: net, Tax: gross - net, Gross: gross}, nil
+}
+
+// checkPlaces returns error if number of places is not in range from 0 to 8.
+func checkPlaces(places int) error {
+	if places < 0 || places > MaxPointsFractional {
+		return fmt.Errorf(
+			"number of places should be from 0 to %d: %d",
+			MaxPointsFractional,
+			places,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddTax_RoundsTax(t *testing.T) {
+	test := assert.New(t)
+
+	tax, err := AddTax(Must(FromString("10.0")), Must(FromString("0.19")), 2, RoundHalfUp)
+	test.NoError(err)
+	test.Equal(Tax{
+		Net:   Must(FromString("10.0")),
+		Tax:   Must(FromString("1.9")),
+		Gross: Must(FromString("11.9")),
+	}, tax)
+
+	tax, err = AddTax(Must(FromString("0.99")), Must(FromString("0.075")), 2, RoundHalfUp)
+	test.NoError(err)
+	test.Equal("0.07000000", tax.Tax.String())
+	test.Equal("1.06000000", tax.Gross.String())
+
+	tax, err = AddTax(Must(FromString("0.99")), Must(FromString("0.075")), 2, RoundUp)
+	test.NoError(err)
+	test.Equal("0.08000000", tax.Tax.String())
+
+	_, err = AddTax(Must(FromString("1.0")), Must(FromString("0.2")), 9, RoundUp)
+	test.Error(err)
+
+	_, err = AddTax(Must(FromString("99999999999.0")), Must(FromString("0.2")), 2, RoundUp)
+	test.Error(err)
+}
+
+func TestExtractTax_Reconciles(t *testing.T) {
+	test := assert.New(t)
+
+	tax, err := ExtractTax(Must(FromString("11.9")), Must(FromString("0.19")), 2, RoundHalfUp)
+	test.NoError(err)
+	test.Equal(Tax{
+		Net:   Must(FromString("10.0")),
+		Tax:   Must(FromString("1.9")),
+		Gross: Must(FromString("11.9")),
+	}, tax)
+
+	rate := Must(FromString("0.21"))
+
+	for cents := 1; cents <= 2000; cents++ {
+		gross := Decimal(cents) * Must(FromString("0.01"))
+
+		for _, mode := range []RoundingMode{RoundDown, RoundUp, RoundHalfEven} {
+			tax, err := ExtractTax(gross, rate, 2, mode)
+			test.NoError(err)
+			test.Equal(gross, tax.Net+tax.Tax)
+			test.Equal(gross, tax.Gross)
+			test.True(tax.Net.Places() <= 2, tax.Net.String())
+			test.True(tax.Tax.Places() <= 2, tax.Tax.String())
+		}
+	}
+
+	tax, err = ExtractTax(Must(FromString("1.0")), Must(FromString("0.2")), 8, RoundDown)
+	test.NoError(err)
+	test.Equal("0.83333333", tax.Net.String())
+	test.Equal("0.16666667", tax.Tax.String())
+
+	tax, err = ExtractTax(Must(FromString("0.001")), 0, 2, RoundUp)
+	test.NoError(err)
+	test.Equal(Must(FromString("0.001")), tax.Net)
+	test.Equal(Decimal(0), tax.Tax)
+
+	_, err = ExtractTax(Must(FromString("1.0")), Decimal(Max-1), 2, RoundDown)
+	test.Error(err)
+
+	_, err = ExtractTax(Must(FromString("1.0")), 0, -1, RoundDown)
+	test.Error(err)
+}
@@ -0,0 +1,47 @@
+package decimal
+
+// TableCell is minimal interface of table cell for report generators: text
+// to render and whether it's number, e.g. to align it right. Cell
+// implements it.
+type TableCell interface {
+	CellText() string
+	Numeric() bool
+}
+
+// CellText returns text of cell.
+func (cell Cell) CellText() string {
+	return cell.Text
+}
+
+// Numeric reports whether cell holds Decimal value.
+func (cell Cell) Numeric() bool {
+	return cell.Decimal
+}
+
+// FormatCell returns numeric Cell with value formatted by given Formatter,
+// or by DefaultFormatter if it's nil.
+func FormatCell(value Decimal, formatter Formatter) Cell {
+	return Cell{Text: value.Format(formatter), Decimal: true}
+}
+
+// TemplateFuncs returns functions for text/template and html/template which
+// render Decimal values with given Formatter, or DefaultFormatter if it's
+// nil:
+//
+// * decimal formats value, e.g. {{decimal .Total}};
+//
+// * decimalCell returns value as TableCell, e.g. for table helpers of
+// report generators.
+//
+// Example:
+//	template.New("invoice").Funcs(decimal.TemplateFuncs(formatter))
+func TemplateFuncs(formatter Formatter) map[string]interface{} {
+	return map[string]interface{}{
+		"decimal": func(value Decimal) string {
+			return value.Format(formatter)
+		},
+		"decimalCell": func(value Decimal) TableCell {
+			return FormatCell(value, formatter)
+		},
+	}
+}
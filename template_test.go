@@ -0,0 +1,48 @@
+package decimal
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateFuncs_FormatsValues(t *testing.T) {
+	test := assert.New(t)
+
+	formatter := NumberFormatter{Places: 2, Point: ",", Group: " "}
+
+	invoice := template.Must(template.New("invoice").
+		Funcs(TemplateFuncs(formatter)).
+		Parse(`{{decimal .Total}} {{with decimalCell .Total}}{{.CellText}} {{.Numeric}}{{end}}`))
+
+	var builder strings.Builder
+	test.NoError(invoice.Execute(&builder, struct{ Total Decimal }{
+		Must(FromString("1234.5")),
+	}))
+	test.Equal("1 234,50 1 234,50 true", builder.String())
+}
+
+func TestTemplateFuncs_SupportsHTMLTemplates(t *testing.T) {
+	test := assert.New(t)
+
+	invoice := htmltemplate.Must(htmltemplate.New("invoice").
+		Funcs(TemplateFuncs(nil)).
+		Parse(`<td>{{decimal .}}</td>`))
+
+	var builder strings.Builder
+	test.NoError(invoice.Execute(&builder, Must(FromString("0.5"))))
+	test.Equal("<td>0.50000000</td>", builder.String())
+}
+
+func TestFormatCell_ReturnsNumericCell(t *testing.T) {
+	test := assert.New(t)
+
+	var cell TableCell = FormatCell(Must(FromString("1.5")), NumberFormatter{Places: 1})
+
+	test.Equal("1.5", cell.CellText())
+	test.True(cell.Numeric())
+	test.False(Cell{Text: "BTC"}.Numeric())
+}
@@ -0,0 +1,52 @@
+package decimal
+
+import (
+	"fmt"
+)
+
+// Traced is Decimal which keeps original input it was parsed from, so it's
+// possible to find out what exactly was received without parsing it twice.
+//
+// Raw input is kept even if parsing fails. Marshaling and String() use
+// parsed value only.
+type Traced struct {
+	Decimal
+
+	// Raw contains input exactly as it was received.
+	Raw string
+}
+
+// Scan parses value from given string/bytes representation, remembers input
+// and return error if value can't be stored in Decimal type.
+// Used in SQL communication.
+func (traced *Traced) Scan(data interface{}) error {
+	switch data := data.(type) {
+	case []byte:
+		return traced.Scan(string(data))
+
+	case string:
+		traced.Raw = data
+		traced.Decimal = 0
+
+		return traced.Decimal.Scan(data)
+
+	default:
+		return fmt.Errorf(
+			"decimal type expected to be []byte, but %T received",
+			data,
+		)
+	}
+}
+
+// UnmarshalText calls Scan() method to read Traced type.
+// Used in json marshaling/unmarshaling.
+func (traced *Traced) UnmarshalText(data []byte) error {
+	return traced.Scan(string(data))
+}
+
+// FromStringTraced returns Traced parsed from string input.
+func FromStringTraced(value string) (Traced, error) {
+	var traced Traced
+	err := traced.Scan(value)
+	return traced, err
+}
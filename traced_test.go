@@ -0,0 +1,44 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraced_Scan_KeepsRawInput(t *testing.T) {
+	test := assert.New(t)
+
+	var actual Traced
+
+	err := actual.Scan([]byte("1.50"))
+	test.NoError(err)
+	test.Equal("1.50", actual.Raw)
+	test.Equal("1.50000000", actual.String())
+}
+
+func TestTraced_Scan_KeepsRawInputOnError(t *testing.T) {
+	test := assert.New(t)
+
+	actual, err := FromStringTraced("1,50")
+	test.Error(err)
+	test.Equal("1,50", actual.Raw)
+	test.Equal(Decimal(0), actual.Decimal)
+}
+
+func TestTraced_UnmarshalJSON_KeepsRawInput(t *testing.T) {
+	test := assert.New(t)
+
+	var actual struct {
+		Amount Traced `json:"amount"`
+	}
+
+	err := json.Unmarshal([]byte(`{"amount":"0.1000"}`), &actual)
+	test.NoError(err)
+	test.Equal("0.1000", actual.Amount.Raw)
+
+	data, err := json.Marshal(actual)
+	test.NoError(err)
+	test.Equal(`{"amount":"0.10000000"}`, string(data))
+}
@@ -0,0 +1,98 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// ValuationReport contains per-asset details of Valuation().
+type ValuationReport struct {
+	// Values contains value of every position in quote currency rounded to
+	// 8 places with DefaultPolicy().
+	Values map[string]Decimal
+
+	// Inexact lists assets which values were rounded, sorted. Sum of Values
+	// may differ from total valuation only if it's not empty.
+	Inexact []string
+}
+
+// Valuation returns total value of given positions in quote currency and
+// report with value of every position. Positions are converted with rates
+// keyed by asset, which should have asset as Base and given quote as Quote;
+// positions in quote currency itself don't need rate.
+//
+// Products and their sum are computed exactly and total is rounded once to
+// 8 places with DefaultPolicy(), so it doesn't accumulate rounding of individual
+// positions. Function will return error if rate is missing or doesn't match
+// asset and quote, or if any value can't be stored in Decimal type.
+func Valuation(
+	positions map[string]Decimal,
+	rates map[string]Rate,
+	quote string,
+) (Decimal, ValuationReport, error) {
+	assets := make([]string, 0, len(positions))
+	for asset := range positions {
+		assets = append(assets, asset)
+	}
+	sort.Strings(assets)
+
+	report := ValuationReport{Values: make(map[string]Decimal, len(positions))}
+	rounding := DefaultPolicy().Rounding
+
+	var total big.Int
+	for _, asset := range assets {
+		position := positions[asset]
+
+		// Exact value in units of 0.0000000000000001.
+		var exact *big.Int
+		if asset == quote {
+			exact = new(big.Int).Mul(bigDecimal(position), bigFractional)
+		} else {
+			rate, ok := rates[asset]
+			if !ok {
+				return 0, ValuationReport{}, fmt.Errorf(
+					"valuation has no rate for %s to %s", asset, quote,
+				)
+			}
+
+			if rate.Base != asset || rate.Quote != quote {
+				return 0, ValuationReport{}, fmt.Errorf(
+					"valuation of %s to %s can't use rate %s to %s",
+					asset,
+					quote,
+					rate.Base,
+					rate.Quote,
+				)
+			}
+
+			exact = product(position, rate.Price)
+		}
+
+		value, ok := fromBig(rounding.apply("valuation.position", exact, bigFractional, 1))
+		if !ok {
+			return 0, ValuationReport{}, fmt.Errorf(
+				"decimal type can't hold value of %s %s in %s",
+				position.String(),
+				asset,
+				quote,
+			)
+		}
+
+		if new(big.Int).Mod(exact, bigFractional).Sign() != 0 {
+			report.Inexact = append(report.Inexact, asset)
+		}
+
+		report.Values[asset] = value
+		total.Add(&total, exact)
+	}
+
+	result, ok := fromBig(rounding.apply("valuation", &total, bigFractional, 1))
+	if !ok {
+		return 0, ValuationReport{}, fmt.Errorf(
+			"decimal type can't hold total valuation in %s", quote,
+		)
+	}
+
+	return result, report, nil
+}
@@ -0,0 +1,67 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValuation_SumsExactValues(t *testing.T) {
+	test := assert.New(t)
+
+	total, report, err := Valuation(
+		map[string]Decimal{
+			"BTC": Must(FromString("0.00000001")),
+			"ETH": Must(FromString("0.00000001")),
+			"USD": Must(FromString("10.0")),
+		},
+		map[string]Rate{
+			"BTC": {Base: "BTC", Quote: "USD", Price: Must(FromString("0.5"))},
+			"ETH": {Base: "ETH", Quote: "USD", Price: Must(FromString("0.5"))},
+		},
+		"USD",
+	)
+	test.NoError(err)
+
+	// Rounded values of positions sum to 10.0, while exact sum is
+	// 10.00000001.
+	test.Equal("10.00000001", total.String())
+	test.Equal(map[string]Decimal{
+		"BTC": 0,
+		"ETH": 0,
+		"USD": Must(FromString("10.0")),
+	}, report.Values)
+	test.Equal([]string{"BTC", "ETH"}, report.Inexact)
+}
+
+func TestValuation_ReturnsErrorOnInvalidRate(t *testing.T) {
+	test := assert.New(t)
+
+	positions := map[string]Decimal{"BTC": Must(FromString("1.0"))}
+
+	_, _, err := Valuation(positions, nil, "USD")
+	test.Error(err)
+	test.Contains(err.Error(), "no rate for BTC to USD")
+
+	_, _, err = Valuation(positions, map[string]Rate{
+		"BTC": {Base: "BTC", Quote: "EUR", Price: Must(FromString("1.0"))},
+	}, "USD")
+	test.Error(err)
+	test.Contains(err.Error(), "can't use rate BTC to EUR")
+}
+
+func TestValuation_ReturnsErrorOnOverflow(t *testing.T) {
+	test := assert.New(t)
+
+	max := Must(FromString("99999999999.0"))
+
+	_, _, err := Valuation(
+		map[string]Decimal{"BTC": max, "USD": max},
+		map[string]Rate{
+			"BTC": {Base: "BTC", Quote: "USD", Price: Must(FromString("1.0"))},
+		},
+		"USD",
+	)
+	test.Error(err)
+	test.Contains(err.Error(), "total valuation")
+}
@@ -0,0 +1,85 @@
+package decimal
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// VWAP accumulates volume-weighted average price of trades, e.g. for
+// candles or index prices. Notional and volume are summed into 128-bit
+// accumulators, so only final result is rounded. Zero value is empty VWAP.
+// VWAP is not safe for concurrent use.
+//
+// Example:
+//	var vwap decimal.VWAP
+//	for _, trade := range trades {
+//		vwap.Add(trade.Price, trade.Amount)
+//	}
+//	price, err := vwap.Value(decimal.RoundHalfEven)
+type VWAP struct {
+	notionalHi, notionalLo uint64
+	volumeHi, volumeLo     uint64
+}
+
+// Add adds trade with given price and quantity. Method will return error
+// and leave VWAP unchanged if notional or volume overflows 128 bits, which
+// needs more than three trades of maximal price and quantity or more than
+// 2^64 trades.
+func (vwap *VWAP) Add(price, quantity Decimal) error {
+	hi, lo := bits.Mul64(price.Uint64(), quantity.Uint64())
+
+	notionalHi, notionalLo, ok := add128(vwap.notionalHi, vwap.notionalLo, hi, lo)
+	if !ok {
+		return fmt.Errorf(
+			"vwap can't hold notional of trade: %s × %s",
+			price.String(),
+			quantity.String(),
+		)
+	}
+
+	volumeHi, volumeLo, ok := add128(vwap.volumeHi, vwap.volumeLo, 0, quantity.Uint64())
+	if !ok {
+		return fmt.Errorf("vwap can't hold volume of trade: %s", quantity.String())
+	}
+
+	vwap.notionalHi, vwap.notionalLo = notionalHi, notionalLo
+	vwap.volumeHi, vwap.volumeLo = volumeHi, volumeLo
+
+	return nil
+}
+
+// Volume returns total quantity of added trades. Method will return error
+// if it can't be stored in Decimal type.
+func (vwap *VWAP) Volume() (Decimal, error) {
+	volume, ok := fromUint128(vwap.volumeHi, vwap.volumeLo)
+	if !ok {
+		return 0, fmt.Errorf("decimal type can't hold vwap volume")
+	}
+
+	return volume, nil
+}
+
+// Value returns average price of added trades weighted by their quantities
+// rounded with given mode. Average never exceeds the greatest price, so
+// method returns error only if total volume is zero.
+func (vwap *VWAP) Value(mode RoundingMode) (Decimal, error) {
+	if vwap.volumeHi == 0 && vwap.volumeLo == 0 {
+		return 0, fmt.Errorf("vwap needs non-zero volume")
+	}
+
+	// Notional is in units of 0.00000001², so quotient is in units of
+	// 0.00000001.
+	price, _ := fromBig(mode.apply(
+		"vwap",
+		bigWords(vwap.notionalHi, vwap.notionalLo),
+		bigWords(vwap.volumeHi, vwap.volumeLo),
+		1,
+	))
+
+	return price, nil
+}
+
+// Reset empties VWAP.
+func (vwap *VWAP) Reset() {
+	*vwap = VWAP{}
+}
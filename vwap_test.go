@@ -0,0 +1,74 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVWAP_WeightsByQuantity(t *testing.T) {
+	test := assert.New(t)
+
+	var vwap VWAP
+
+	_, err := vwap.Value(RoundDown)
+	test.Error(err)
+
+	test.NoError(vwap.Add(Must(FromString("100.0")), Must(FromString("1.0"))))
+	test.NoError(vwap.Add(Must(FromString("101.0")), Must(FromString("3.0"))))
+
+	price, err := vwap.Value(RoundDown)
+	test.NoError(err)
+	test.Equal("100.75000000", price.String())
+
+	volume, err := vwap.Volume()
+	test.NoError(err)
+	test.Equal("4.00000000", volume.String())
+
+	test.NoError(vwap.Add(Must(FromString("1000.0")), 0))
+
+	price, err = vwap.Value(RoundDown)
+	test.NoError(err)
+	test.Equal("100.75000000", price.String())
+
+	vwap.Reset()
+	test.Equal(VWAP{}, vwap)
+}
+
+func TestVWAP_RoundsOnce(t *testing.T) {
+	test := assert.New(t)
+
+	var vwap VWAP
+	test.NoError(vwap.Add(Must(FromString("1.0")), Must(FromString("1.0"))))
+	test.NoError(vwap.Add(Must(FromString("2.0")), Must(FromString("2.0"))))
+
+	price, err := vwap.Value(RoundDown)
+	test.NoError(err)
+	test.Equal("1.66666666", price.String())
+
+	price, err = vwap.Value(RoundHalfUp)
+	test.NoError(err)
+	test.Equal("1.66666667", price.String())
+}
+
+func TestVWAP_HoldsLargeTotals(t *testing.T) {
+	test := assert.New(t)
+
+	max := Decimal(Max - 1)
+
+	var vwap VWAP
+	for i := 0; i < 3; i++ {
+		test.NoError(vwap.Add(max, max))
+	}
+
+	price, err := vwap.Value(RoundDown)
+	test.NoError(err)
+	test.Equal(max, price)
+
+	_, err = vwap.Volume()
+	test.Error(err)
+
+	before := vwap
+	test.Error(vwap.Add(max, max))
+	test.Equal(before, vwap)
+}